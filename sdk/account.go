@@ -7,6 +7,8 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"slices"
+	"time"
 )
 
 // GetAccountParams are parameters for the account/read method.
@@ -33,6 +35,21 @@ func (r *GetAccountResponse) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// Plan returns the plan tier of the signed-in account. It returns
+// PlanTypeUnknown when there is no account or the account kind does not report
+// a plan (API key and unrecognized accounts). The server reports no per-feature
+// entitlements, so callers that gate features must map tiers themselves.
+func (r GetAccountResponse) Plan() PlanType {
+	if r.Account == nil {
+		return PlanTypeUnknown
+	}
+	chatgpt, ok := r.Account.Value.(*ChatgptAccount)
+	if !ok || chatgpt == nil || chatgpt.PlanType == "" {
+		return PlanTypeUnknown
+	}
+	return chatgpt.PlanType
+}
+
 // AccountWrapper wraps the Account interface for JSON marshaling
 type AccountWrapper struct {
 	Value Account
@@ -139,19 +156,32 @@ const (
 	PlanTypeUnknown                     PlanType = "unknown"
 )
 
-var validPlanTypes = map[PlanType]struct{}{
-	PlanTypeFree:                        {},
-	PlanTypeGo:                          {},
-	PlanTypePlus:                        {},
-	PlanTypePro:                         {},
-	PlanTypeProLite:                     {},
-	PlanTypeTeam:                        {},
-	PlanTypeBusiness:                    {},
-	PlanTypeEnterprise:                  {},
-	PlanTypeEdu:                         {},
-	PlanTypeSelfServeBusinessUsageBased: {},
-	PlanTypeEnterpriseCBPUsageBased:     {},
-	PlanTypeUnknown:                     {},
+var allPlanTypes = []PlanType{
+	PlanTypeFree,
+	PlanTypeGo,
+	PlanTypePlus,
+	PlanTypePro,
+	PlanTypeProLite,
+	PlanTypeTeam,
+	PlanTypeBusiness,
+	PlanTypeEnterprise,
+	PlanTypeEdu,
+	PlanTypeSelfServeBusinessUsageBased,
+	PlanTypeEnterpriseCBPUsageBased,
+	PlanTypeUnknown,
+}
+
+var validPlanTypes = newEnumSet(allPlanTypes...)
+
+// PlanTypeValues returns every known PlanType value in protocol order.
+func PlanTypeValues() []PlanType {
+	return slices.Clone(allPlanTypes)
+}
+
+// Valid reports whether p is a known PlanType value.
+func (p PlanType) Valid() bool {
+	_, ok := validPlanTypes[p]
+	return ok
 }
 
 func validatePlanTypeField(field string, value PlanType) error {
@@ -277,6 +307,56 @@ type RateLimitWindow struct {
 	WindowDurationMins *int64 `json:"windowDurationMins,omitempty"`
 }
 
+// RemainingPercent returns the share of the window's quota still available,
+// clamped to the range 0-100. The server reports usage only as a percentage,
+// so absolute remaining and limit counts are not available.
+func (w RateLimitWindow) RemainingPercent() int32 {
+	return min(max(100-w.UsedPercent, 0), 100)
+}
+
+// Exhausted reports whether the window's quota is fully used.
+func (w RateLimitWindow) Exhausted() bool {
+	return w.UsedPercent >= 100
+}
+
+// ResetTime returns when the window resets, parsed from ResetsAt (Unix
+// seconds). It returns false when the server did not report a reset time.
+func (w RateLimitWindow) ResetTime() (time.Time, bool) {
+	if w.ResetsAt == nil {
+		return time.Time{}, false
+	}
+	return time.Unix(*w.ResetsAt, 0), true
+}
+
+// Duration returns the window's length, or false when it is not reported.
+func (w RateLimitWindow) Duration() (time.Duration, bool) {
+	if w.WindowDurationMins == nil {
+		return 0, false
+	}
+	return time.Duration(*w.WindowDurationMins) * time.Minute, true
+}
+
+// RetryAfter returns how long to wait before the snapshot's exhausted windows
+// reset, taking the latest reset when both are exhausted. It returns zero when
+// no window is exhausted, when an exhausted window has no reported reset time,
+// or when the reset has already passed.
+func (r RateLimitSnapshot) RetryAfter() time.Duration {
+	return r.retryAfter(time.Now())
+}
+
+func (r RateLimitSnapshot) retryAfter(now time.Time) time.Duration {
+	var wait time.Duration
+	for _, window := range []*RateLimitWindow{r.Primary, r.Secondary} {
+		if window == nil || !window.Exhausted() {
+			continue
+		}
+		if resetAt, ok := window.ResetTime(); ok {
+			wait = max(wait, resetAt.Sub(now))
+		}
+	}
+	return wait
+}
+
 // AddCreditsNudgeCreditType identifies which credit category should be nudged.
 type AddCreditsNudgeCreditType string
 
@@ -285,9 +365,22 @@ const (
 	AddCreditsNudgeCreditTypeUsageLimit AddCreditsNudgeCreditType = "usage_limit"
 )
 
-var validAddCreditsNudgeCreditTypes = map[AddCreditsNudgeCreditType]struct{}{
-	AddCreditsNudgeCreditTypeCredits:    {},
-	AddCreditsNudgeCreditTypeUsageLimit: {},
+var allAddCreditsNudgeCreditTypes = []AddCreditsNudgeCreditType{
+	AddCreditsNudgeCreditTypeCredits,
+	AddCreditsNudgeCreditTypeUsageLimit,
+}
+
+var validAddCreditsNudgeCreditTypes = newEnumSet(allAddCreditsNudgeCreditTypes...)
+
+// AddCreditsNudgeCreditTypeValues returns every known AddCreditsNudgeCreditType value in protocol order.
+func AddCreditsNudgeCreditTypeValues() []AddCreditsNudgeCreditType {
+	return slices.Clone(allAddCreditsNudgeCreditTypes)
+}
+
+// Valid reports whether a is a known AddCreditsNudgeCreditType value.
+func (a AddCreditsNudgeCreditType) Valid() bool {
+	_, ok := validAddCreditsNudgeCreditTypes[a]
+	return ok
 }
 
 func validateAddCreditsNudgeCreditTypeField(field string, value AddCreditsNudgeCreditType) error {
@@ -314,9 +407,22 @@ const (
 	AddCreditsNudgeEmailStatusCooldownActive AddCreditsNudgeEmailStatus = "cooldown_active"
 )
 
-var validAddCreditsNudgeEmailStatuses = map[AddCreditsNudgeEmailStatus]struct{}{
-	AddCreditsNudgeEmailStatusSent:           {},
-	AddCreditsNudgeEmailStatusCooldownActive: {},
+var allAddCreditsNudgeEmailStatuses = []AddCreditsNudgeEmailStatus{
+	AddCreditsNudgeEmailStatusSent,
+	AddCreditsNudgeEmailStatusCooldownActive,
+}
+
+var validAddCreditsNudgeEmailStatuses = newEnumSet(allAddCreditsNudgeEmailStatuses...)
+
+// AddCreditsNudgeEmailStatusValues returns every known AddCreditsNudgeEmailStatus value in protocol order.
+func AddCreditsNudgeEmailStatusValues() []AddCreditsNudgeEmailStatus {
+	return slices.Clone(allAddCreditsNudgeEmailStatuses)
+}
+
+// Valid reports whether a is a known AddCreditsNudgeEmailStatus value.
+func (a AddCreditsNudgeEmailStatus) Valid() bool {
+	_, ok := validAddCreditsNudgeEmailStatuses[a]
+	return ok
 }
 
 func validateAddCreditsNudgeEmailStatusField(field string, value AddCreditsNudgeEmailStatus) error {
@@ -666,6 +772,24 @@ const (
 	CancelLoginAccountStatusNotFound CancelLoginAccountStatus = "notFound"
 )
 
+var allCancelLoginAccountStatuses = []CancelLoginAccountStatus{
+	CancelLoginAccountStatusCanceled,
+	CancelLoginAccountStatusNotFound,
+}
+
+var validCancelLoginAccountStatuses = newEnumSet(allCancelLoginAccountStatuses...)
+
+// CancelLoginAccountStatusValues returns every known CancelLoginAccountStatus value in protocol order.
+func CancelLoginAccountStatusValues() []CancelLoginAccountStatus {
+	return slices.Clone(allCancelLoginAccountStatuses)
+}
+
+// Valid reports whether c is a known CancelLoginAccountStatus value.
+func (c CancelLoginAccountStatus) Valid() bool {
+	_, ok := validCancelLoginAccountStatuses[c]
+	return ok
+}
+
 // LogoutAccountResponse is the response from account/logout
 type LogoutAccountResponse struct {
 	// Empty response per spec
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 )
 
 // AuthMode represents the authentication mode
@@ -16,11 +17,24 @@ const (
 	AuthModeAgentIdentity     AuthMode = "agentIdentity"
 )
 
-var validAuthModes = map[AuthMode]struct{}{
-	AuthModeAPIKey:            {},
-	AuthModeChatGPT:           {},
-	AuthModeChatGPTAuthTokens: {},
-	AuthModeAgentIdentity:     {},
+var allAuthModes = []AuthMode{
+	AuthModeAPIKey,
+	AuthModeChatGPT,
+	AuthModeChatGPTAuthTokens,
+	AuthModeAgentIdentity,
+}
+
+var validAuthModes = newEnumSet(allAuthModes...)
+
+// AuthModeValues returns every known AuthMode value in protocol order.
+func AuthModeValues() []AuthMode {
+	return slices.Clone(allAuthModes)
+}
+
+// Valid reports whether a is a known AuthMode value.
+func (a AuthMode) Valid() bool {
+	_, ok := validAuthModes[a]
+	return ok
 }
 
 func validateOptionalAuthModeField(field string, value *AuthMode) error {
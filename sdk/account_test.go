@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	codex "github.com/dominicnunez/codex-sdk-go/sdk"
 )
@@ -1170,3 +1171,110 @@ func TestAccountWrapperRoundTrip(t *testing.T) {
 		})
 	}
 }
+
+func TestGetAccountResponsePlan(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want codex.PlanType
+	}{
+		{
+			name: "chatgpt",
+			data: `{"requiresOpenaiAuth":true,"account":{"type":"chatgpt","email":"a@b.com","planType":"enterprise"}}`,
+			want: codex.PlanTypeEnterprise,
+		},
+		{
+			name: "api key",
+			data: `{"requiresOpenaiAuth":false,"account":{"type":"apiKey"}}`,
+			want: codex.PlanTypeUnknown,
+		},
+		{
+			name: "unknown account",
+			data: `{"requiresOpenaiAuth":false,"account":{"type":"future","planType":"pro"}}`,
+			want: codex.PlanTypeUnknown,
+		},
+		{
+			name: "no account",
+			data: `{"requiresOpenaiAuth":true,"account":null}`,
+			want: codex.PlanTypeUnknown,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var resp codex.GetAccountResponse
+			if err := json.Unmarshal([]byte(tt.data), &resp); err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+			if got := resp.Plan(); got != tt.want {
+				t.Errorf("Plan() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRateLimitWindowHelpers(t *testing.T) {
+	resetsAt := time.Now().Add(time.Hour).Unix()
+	window := codex.RateLimitWindow{UsedPercent: 100, ResetsAt: &resetsAt, WindowDurationMins: ptr(int64(300))}
+
+	if got := window.RemainingPercent(); got != 0 {
+		t.Errorf("RemainingPercent() = %d; want 0", got)
+	}
+	if !window.Exhausted() {
+		t.Error("Exhausted() = false; want true at 100% used")
+	}
+	if got, ok := window.ResetTime(); !ok || got.Unix() != resetsAt {
+		t.Errorf("ResetTime() = %v, %v; want %d, true", got, ok, resetsAt)
+	}
+	if got, ok := window.Duration(); !ok || got != 5*time.Hour {
+		t.Errorf("Duration() = %v, %v; want 5h, true", got, ok)
+	}
+
+	unknown := codex.RateLimitWindow{UsedPercent: 40}
+	if got := unknown.RemainingPercent(); got != 60 {
+		t.Errorf("RemainingPercent() = %d; want 60", got)
+	}
+	if _, ok := unknown.ResetTime(); ok {
+		t.Error("ResetTime() reported a reset time the server did not send")
+	}
+	if _, ok := unknown.Duration(); ok {
+		t.Error("Duration() reported a window length the server did not send")
+	}
+}
+
+func TestRateLimitSnapshotRetryAfter(t *testing.T) {
+	soon := time.Now().Add(10 * time.Minute).Unix()
+	later := time.Now().Add(time.Hour).Unix()
+
+	tests := []struct {
+		name     string
+		snapshot codex.RateLimitSnapshot
+		min, max time.Duration
+	}{
+		{
+			name:     "not exhausted",
+			snapshot: codex.RateLimitSnapshot{Primary: &codex.RateLimitWindow{UsedPercent: 99, ResetsAt: &later}},
+		},
+		{
+			name:     "exhausted without reset time",
+			snapshot: codex.RateLimitSnapshot{Primary: &codex.RateLimitWindow{UsedPercent: 100}},
+		},
+		{
+			name: "latest exhausted window wins",
+			snapshot: codex.RateLimitSnapshot{
+				Primary:   &codex.RateLimitWindow{UsedPercent: 100, ResetsAt: &soon},
+				Secondary: &codex.RateLimitWindow{UsedPercent: 100, ResetsAt: &later},
+			},
+			min: 59 * time.Minute,
+			max: time.Hour,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.snapshot.RetryAfter(); got < tt.min || got > tt.max {
+				t.Errorf("RetryAfter() = %v; want between %v and %v", got, tt.min, tt.max)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package codex
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
 )
 
 // AdditionalFileSystemPermissions requests or grants extra filesystem access.
@@ -74,6 +75,24 @@ const (
 	PermissionGrantScopeSession PermissionGrantScope = "session"
 )
 
+var allPermissionGrantScopes = []PermissionGrantScope{
+	PermissionGrantScopeTurn,
+	PermissionGrantScopeSession,
+}
+
+var validPermissionGrantScopes = newEnumSet(allPermissionGrantScopes...)
+
+// PermissionGrantScopeValues returns every known PermissionGrantScope value in protocol order.
+func PermissionGrantScopeValues() []PermissionGrantScope {
+	return slices.Clone(allPermissionGrantScopes)
+}
+
+// Valid reports whether p is a known PermissionGrantScope value.
+func (p PermissionGrantScope) Valid() bool {
+	_, ok := validPermissionGrantScopes[p]
+	return ok
+}
+
 // PermissionsRequestApprovalResponse represents the approval response for additional permissions.
 type PermissionsRequestApprovalResponse struct {
 	Permissions      GrantedPermissionProfile `json:"permissions"`
@@ -116,6 +135,24 @@ const (
 	McpServerElicitationModeURL  McpServerElicitationMode = "url"
 )
 
+var allMcpServerElicitationModes = []McpServerElicitationMode{
+	McpServerElicitationModeForm,
+	McpServerElicitationModeURL,
+}
+
+var validMcpServerElicitationModes = newEnumSet(allMcpServerElicitationModes...)
+
+// McpServerElicitationModeValues returns every known McpServerElicitationMode value in protocol order.
+func McpServerElicitationModeValues() []McpServerElicitationMode {
+	return slices.Clone(allMcpServerElicitationModes)
+}
+
+// Valid reports whether m is a known McpServerElicitationMode value.
+func (m McpServerElicitationMode) Valid() bool {
+	_, ok := validMcpServerElicitationModes[m]
+	return ok
+}
+
 // McpElicitationSchema is the typed form schema for an MCP elicitation request.
 type McpElicitationSchema struct {
 	Schema     *string                `json:"$schema,omitempty"`
@@ -193,6 +230,25 @@ const (
 	McpServerElicitationActionCancel  McpServerElicitationAction = "cancel"
 )
 
+var allMcpServerElicitationActions = []McpServerElicitationAction{
+	McpServerElicitationActionAccept,
+	McpServerElicitationActionDecline,
+	McpServerElicitationActionCancel,
+}
+
+var validMcpServerElicitationActions = newEnumSet(allMcpServerElicitationActions...)
+
+// McpServerElicitationActionValues returns every known McpServerElicitationAction value in protocol order.
+func McpServerElicitationActionValues() []McpServerElicitationAction {
+	return slices.Clone(allMcpServerElicitationActions)
+}
+
+// Valid reports whether m is a known McpServerElicitationAction value.
+func (m McpServerElicitationAction) Valid() bool {
+	_, ok := validMcpServerElicitationActions[m]
+	return ok
+}
+
 // McpServerElicitationRequestResponse represents the response to an MCP elicitation request.
 type McpServerElicitationRequestResponse struct {
 	Meta    interface{}                `json:"_meta,omitempty"`
@@ -0,0 +1,33 @@
+package codex
+
+import "context"
+
+// AutoApproveReadsHandler returns an OnExecCommandApproval handler for the
+// legacy execCommandApproval request that approves a command only when every
+// entry in its ParsedCmd is a read, list-files, or search command. Commands
+// with an unknown part, or with no parsed commands at all, are denied, so
+// anything that might write is never approved automatically. Older CLIs send
+// this request; newer ones use item/commandExecution/requestApproval.
+func AutoApproveReadsHandler() func(context.Context, ExecCommandApprovalParams) (ExecCommandApprovalResponse, error) {
+	return func(_ context.Context, params ExecCommandApprovalParams) (ExecCommandApprovalResponse, error) {
+		decision := "approved"
+		if !parsedCommandsOnlyRead(params.ParsedCmd) {
+			decision = "denied"
+		}
+		return ExecCommandApprovalResponse{Decision: ReviewDecisionWrapper{Value: decision}}, nil
+	}
+}
+
+func parsedCommandsOnlyRead(commands []ParsedCommandWrapper) bool {
+	if len(commands) == 0 {
+		return false
+	}
+	for _, command := range commands {
+		switch command.Value.(type) {
+		case *ReadParsedCommand, *ListFilesParsedCommand, *SearchParsedCommand:
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -294,6 +294,49 @@ type ApplyNetworkPolicyAmendmentDecision struct {
 	NetworkPolicyAmendment NetworkPolicyAmendment `json:"network_policy_amendment"`
 }
 
+// NetworkPolicyDecision returns a command execution approval decision that
+// persists a network policy rule allowing or denying future requests to host,
+// as chosen by action. Network policy rules are keyed by host only, so
+// protocol is validated but not sent on the wire; pass the protocol from the
+// request's NetworkApprovalContext so a policy engine cannot accidentally
+// answer for a protocol the server never asked about.
+func NetworkPolicyDecision(action NetworkPolicyRuleAction, host string, protocol NetworkApprovalProtocol) (CommandExecutionApprovalDecisionWrapper, error) {
+	if err := validateNetworkApprovalProtocolField("protocol", protocol); err != nil {
+		return CommandExecutionApprovalDecisionWrapper{}, err
+	}
+	amendment := NetworkPolicyAmendment{Action: action, Host: host}
+	if err := validateNetworkPolicyAmendment(amendment); err != nil {
+		return CommandExecutionApprovalDecisionWrapper{}, err
+	}
+	return CommandExecutionApprovalDecisionWrapper{
+		Value: ApplyNetworkPolicyAmendmentDecision{NetworkPolicyAmendment: amendment},
+	}, nil
+}
+
+// AllowNetworkHostDecision returns a decision that persists a network policy
+// rule allowing future requests to host. Rules are keyed by host only; see
+// NetworkPolicyDecision for how protocol is used.
+func AllowNetworkHostDecision(host string, protocol NetworkApprovalProtocol) (CommandExecutionApprovalDecisionWrapper, error) {
+	return NetworkPolicyDecision(NetworkPolicyRuleActionAllow, host, protocol)
+}
+
+// DenyNetworkHostDecision returns a decision that persists a network policy
+// rule denying future requests to host. Rules are keyed by host only; see
+// NetworkPolicyDecision for how protocol is used.
+func DenyNetworkHostDecision(host string, protocol NetworkApprovalProtocol) (CommandExecutionApprovalDecisionWrapper, error) {
+	return NetworkPolicyDecision(NetworkPolicyRuleActionDeny, host, protocol)
+}
+
+// NetworkPolicyDecision returns a decision that applies action to the host in
+// the request's NetworkApprovalContext. It returns an error when the request
+// carries no network approval context.
+func (p CommandExecutionRequestApprovalParams) NetworkPolicyDecision(action NetworkPolicyRuleAction) (CommandExecutionApprovalDecisionWrapper, error) {
+	if p.NetworkApprovalContext == nil {
+		return CommandExecutionApprovalDecisionWrapper{}, errors.New("approval request has no networkApprovalContext")
+	}
+	return NetworkPolicyDecision(action, p.NetworkApprovalContext.Host, p.NetworkApprovalContext.Protocol)
+}
+
 // UnknownCommandExecutionApprovalDecision represents an unrecognized command execution approval decision
 // variant from a newer protocol version.
 type UnknownCommandExecutionApprovalDecision struct {
@@ -0,0 +1,165 @@
+package codex
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// DescribeCommandAction renders a parsed command action as a short sentence
+// for approval prompts, such as "Read file src/main.go" or
+// "Search for 'foo' in /repo". Unparsed commands are described by their
+// command line. It returns "" when the wrapper holds no action.
+func DescribeCommandAction(action CommandActionWrapper) string {
+	switch value := action.Value.(type) {
+	case *ReadCommandAction:
+		return describeRead(value.Path, value.Name)
+	case *ListFilesCommandAction:
+		return describeListFiles(value.Path)
+	case *SearchCommandAction:
+		return describeSearch(value.Query, value.Path)
+	case *UnknownCommandAction:
+		return describeUnknownCommand(value.Command)
+	default:
+		return ""
+	}
+}
+
+// DescribeParsedCommand is the DescribeCommandAction equivalent for the legacy
+// ParsedCommandWrapper carried by ExecCommandApprovalParams.
+func DescribeParsedCommand(command ParsedCommandWrapper) string {
+	switch value := command.Value.(type) {
+	case *ReadParsedCommand:
+		return describeRead(value.Path, value.Name)
+	case *ListFilesParsedCommand:
+		return describeListFiles(value.Path)
+	case *SearchParsedCommand:
+		return describeSearch(value.Query, value.Path)
+	case *UnknownParsedCommand:
+		return describeUnknownCommand(value.Cmd)
+	default:
+		return ""
+	}
+}
+
+func describeRead(path, name string) string {
+	if path == "" {
+		path = name
+	}
+	return "Read file " + path
+}
+
+func describeListFiles(path *string) string {
+	if path == nil || *path == "" {
+		return "List files"
+	}
+	return "List files in " + *path
+}
+
+func describeSearch(query, path *string) string {
+	description := "Search"
+	if query != nil && *query != "" {
+		description += " for '" + *query + "'"
+	}
+	if path != nil && *path != "" {
+		description += " in " + *path
+	}
+	return description
+}
+
+func describeUnknownCommand(command string) string {
+	if command == "" {
+		return "Run command"
+	}
+	return "Run " + command
+}
+
+// Summarize renders the request's parsed command actions as one line, such as
+// "Read file src/main.go, search for 'foo' in /repo". Without parsed actions
+// it describes the raw command, and it returns "" if the request carries
+// neither.
+func (p CommandExecutionRequestApprovalParams) Summarize() string {
+	var parts []string
+	if p.CommandActions != nil {
+		for _, action := range *p.CommandActions {
+			if description := DescribeCommandAction(action); description != "" {
+				if len(parts) > 0 {
+					description = lowerFirst(description)
+				}
+				parts = append(parts, description)
+			}
+		}
+	}
+	if len(parts) == 0 && p.Command != nil && *p.Command != "" {
+		return describeUnknownCommand(*p.Command)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Paths returns the distinct paths named by the request's parsed command
+// actions, in the order they first appear.
+func (p CommandExecutionRequestApprovalParams) Paths() []string {
+	if p.CommandActions == nil {
+		return nil
+	}
+	var paths []string
+	seen := make(map[string]struct{})
+	add := func(path string) {
+		if path == "" {
+			return
+		}
+		if _, ok := seen[path]; ok {
+			return
+		}
+		seen[path] = struct{}{}
+		paths = append(paths, path)
+	}
+	for _, action := range *p.CommandActions {
+		switch value := action.Value.(type) {
+		case *ReadCommandAction:
+			add(value.Path)
+		case *ListFilesCommandAction:
+			if value.Path != nil {
+				add(*value.Path)
+			}
+		case *SearchCommandAction:
+			if value.Path != nil {
+				add(*value.Path)
+			}
+		}
+	}
+	return paths
+}
+
+func lowerFirst(s string) string {
+	r, size := utf8.DecodeRuneInString(s)
+	return string(unicode.ToLower(r)) + s[size:]
+}
+
+// String renders the network access being requested, such as
+// "connect to example.com over HTTPS".
+func (c NetworkApprovalContext) String() string {
+	return fmt.Sprintf("connect to %s over %s", c.Host, describeNetworkProtocol(c.Protocol))
+}
+
+func describeNetworkProtocol(protocol NetworkApprovalProtocol) string {
+	switch protocol {
+	case NetworkApprovalProtocolHTTP:
+		return "HTTP"
+	case NetworkApprovalProtocolHTTPS:
+		return "HTTPS"
+	case NetworkApprovalProtocolSocks5TCP:
+		return "SOCKS5 TCP"
+	case NetworkApprovalProtocolSocks5UDP:
+		return "SOCKS5 UDP"
+	default:
+		return string(protocol)
+	}
+}
+
+// RequestsNetwork reports whether the request asks for network access, in
+// which case NetworkApprovalContext describes the host and protocol.
+func (p CommandExecutionRequestApprovalParams) RequestsNetwork() bool {
+	return p.NetworkApprovalContext != nil
+}
@@ -36,6 +36,31 @@ func (p *DynamicToolCallParams) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// RawArguments returns Arguments marshaled as JSON, reflecting any changes
+// made to Arguments after decoding. Decoded numbers are float64, so integers
+// beyond 2^53 do not round-trip exactly. It returns nil if Arguments cannot
+// be marshaled.
+func (p DynamicToolCallParams) RawArguments() json.RawMessage {
+	data, err := json.Marshal(p.Arguments)
+	if err != nil {
+		return nil
+	}
+	return data
+}
+
+// UnmarshalArguments decodes the tool call arguments into v, typically a
+// pointer to a struct describing the tool's input.
+func (p DynamicToolCallParams) UnmarshalArguments(v any) error {
+	raw := p.RawArguments()
+	if raw == nil {
+		return fmt.Errorf("dynamic tool call %s: arguments are not valid JSON", p.Tool)
+	}
+	if err := json.Unmarshal(raw, v); err != nil {
+		return fmt.Errorf("dynamic tool call %s: decode arguments: %w", p.Tool, err)
+	}
+	return nil
+}
+
 // DynamicToolCallResponse represents the response to a dynamic tool call.
 type DynamicToolCallResponse struct {
 	Success      bool                                      `json:"success"`
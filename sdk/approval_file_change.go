@@ -4,6 +4,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 )
 
 // ApplyPatchApprovalParams represents the parameters for a server→client applyPatchApproval request.
@@ -178,6 +181,162 @@ func (w FileChangeWrapper) MarshalJSON() ([]byte, error) {
 	return json.Marshal(w.Value)
 }
 
+// Validate checks that every file change in the patch is well formed: each
+// update carries a syntactically valid unified diff (or is a pure move), no
+// path is empty, and no move targets a path that another change in the same
+// patch adds, updates, or moves to. Changes of a type this SDK does not know
+// cannot be checked and are reported as errors. Empty add and delete contents
+// are allowed because empty files are legitimate.
+//
+// Approval handlers can call Validate to decline obviously broken patches
+// with a clear reason. Paths are checked in sorted order, so the error for a
+// given patch is deterministic.
+func (p ApplyPatchApprovalParams) Validate() error {
+	paths := make([]string, 0, len(p.FileChanges))
+	for path := range p.FileChanges {
+		paths = append(paths, path)
+	}
+	slices.Sort(paths)
+
+	moveSources := make(map[string]string)
+	for _, path := range paths {
+		if path == "" {
+			return errors.New("fileChanges: empty path")
+		}
+		switch change := p.FileChanges[path].Value.(type) {
+		case *AddFileChange, *DeleteFileChange:
+		case *UpdateFileChange:
+			if change.MovePath != nil && *change.MovePath == "" {
+				return fmt.Errorf("fileChanges[%q]: empty move_path", path)
+			}
+			if change.UnifiedDiff == "" && change.MovePath == nil {
+				return fmt.Errorf("fileChanges[%q]: update has neither a diff nor a move_path", path)
+			}
+			if err := validateUnifiedDiff(change.UnifiedDiff); err != nil {
+				return fmt.Errorf("fileChanges[%q]: %w", path, err)
+			}
+			if change.MovePath == nil || *change.MovePath == path {
+				continue
+			}
+			target := *change.MovePath
+			if other, ok := moveSources[target]; ok {
+				return fmt.Errorf("fileChanges[%q]: move_path %q is also the move target of %q", path, target, other)
+			}
+			moveSources[target] = path
+			if existing, ok := p.FileChanges[target]; ok {
+				if _, deleted := existing.Value.(*DeleteFileChange); !deleted {
+					return fmt.Errorf("fileChanges[%q]: move_path %q conflicts with another change to that path", path, target)
+				}
+			}
+		case *UnknownFileChange:
+			return fmt.Errorf("fileChanges[%q]: cannot validate unknown change type %q", path, change.Type)
+		case nil:
+			return fmt.Errorf("fileChanges[%q]: missing change", path)
+		default:
+			return fmt.Errorf("fileChanges[%q]: unsupported change %T", path, change)
+		}
+	}
+	return nil
+}
+
+// validateUnifiedDiff checks the hunk structure of a unified diff: optional
+// ---/+++ file headers followed by @@ hunks whose line counts match their
+// bodies.
+func validateUnifiedDiff(diff string) error {
+	if diff == "" {
+		return nil
+	}
+	lines := strings.Split(strings.TrimSuffix(diff, "\n"), "\n")
+
+	i := 0
+	for i < len(lines) && !strings.HasPrefix(lines[i], "@@") {
+		if !strings.HasPrefix(lines[i], "--- ") && !strings.HasPrefix(lines[i], "+++ ") &&
+			!strings.HasPrefix(lines[i], "diff ") && !strings.HasPrefix(lines[i], "index ") {
+			return fmt.Errorf("unified diff line %d: expected hunk header, got %q", i+1, lines[i])
+		}
+		i++
+	}
+	if i == len(lines) {
+		return errors.New("unified diff has no hunks")
+	}
+
+	for i < len(lines) {
+		oldCount, newCount, err := parseUnifiedDiffHunkHeader(lines[i])
+		if err != nil {
+			return fmt.Errorf("unified diff line %d: %w", i+1, err)
+		}
+		header := i + 1
+		i++
+		for oldCount > 0 || newCount > 0 {
+			if i == len(lines) {
+				return fmt.Errorf("unified diff line %d: hunk is shorter than its header declares", header)
+			}
+			line := lines[i]
+			switch {
+			case strings.HasPrefix(line, " "), line == "":
+				oldCount--
+				newCount--
+			case strings.HasPrefix(line, "-"):
+				oldCount--
+			case strings.HasPrefix(line, "+"):
+				newCount--
+			case strings.HasPrefix(line, "\\"):
+			default:
+				return fmt.Errorf("unified diff line %d: unexpected line %q in hunk", i+1, line)
+			}
+			if oldCount < 0 || newCount < 0 {
+				return fmt.Errorf("unified diff line %d: hunk is longer than its header declares", header)
+			}
+			i++
+		}
+		for i < len(lines) && strings.HasPrefix(lines[i], "\\") {
+			i++
+		}
+	}
+	return nil
+}
+
+// parseUnifiedDiffHunkHeader parses "@@ -l[,s] +l[,s] @@" and returns the
+// old and new line counts. An omitted count means one line.
+func parseUnifiedDiffHunkHeader(line string) (int, int, error) {
+	rest, ok := strings.CutPrefix(line, "@@ -")
+	if !ok {
+		return 0, 0, fmt.Errorf("expected hunk header, got %q", line)
+	}
+	ranges, _, ok := strings.Cut(rest, " @@")
+	if !ok {
+		return 0, 0, fmt.Errorf("malformed hunk header %q", line)
+	}
+	oldRange, newRange, ok := strings.Cut(ranges, " +")
+	if !ok {
+		return 0, 0, fmt.Errorf("malformed hunk header %q", line)
+	}
+	oldCount, err := parseUnifiedDiffRangeCount(oldRange)
+	if err != nil {
+		return 0, 0, fmt.Errorf("malformed hunk header %q: %w", line, err)
+	}
+	newCount, err := parseUnifiedDiffRangeCount(newRange)
+	if err != nil {
+		return 0, 0, fmt.Errorf("malformed hunk header %q: %w", line, err)
+	}
+	return oldCount, newCount, nil
+}
+
+func parseUnifiedDiffRangeCount(r string) (int, error) {
+	start, count, hasCount := strings.Cut(r, ",")
+	if _, err := strconv.ParseUint(start, 10, 32); err != nil {
+		return 0, err
+	}
+	if !hasCount {
+		return 1, nil
+	}
+	n, err := strconv.ParseUint(count, 10, 32)
+	if err != nil {
+		return 0, err
+	}
+	return int(n), nil
+}
+
 // ApplyPatchApprovalResponse represents the response to an applyPatchApproval request.
 //
 // Deprecated: Use FileChangeRequestApprovalResponse instead.
@@ -395,6 +554,30 @@ func (p *FileChangeRequestApprovalParams) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// RequestedGrantRoot reports the directory the agent asked to be allowed to
+// write under for the rest of the session, if any. The protocol has no
+// separate grant in the response: approving with
+// FileChangeApprovalDecisionAcceptForSession is the session-wide approval, and
+// the schema notes the server may not yet honor the root itself, so later
+// writes under it can still prompt.
+func (p FileChangeRequestApprovalParams) RequestedGrantRoot() (string, bool) {
+	return grantRootValue(p.GrantRoot)
+}
+
+// RequestedGrantRoot reports the directory the agent asked to be allowed to
+// write under for the rest of the session, if any. See
+// FileChangeRequestApprovalParams.RequestedGrantRoot.
+func (p ApplyPatchApprovalParams) RequestedGrantRoot() (string, bool) {
+	return grantRootValue(p.GrantRoot)
+}
+
+func grantRootValue(root *string) (string, bool) {
+	if root == nil || *root == "" {
+		return "", false
+	}
+	return *root, true
+}
+
 func validateApprovalPathField(value string, cwd *string, field string) (string, error) {
 	if cwd == nil {
 		return validateInboundAbsolutePathField(field, value)
@@ -0,0 +1,170 @@
+package codex
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// WithCoalescedFileApprovals batches item/fileChange/requestApproval requests
+// so one prompt can cover a whole patch. When ApprovalHandlers has an
+// OnBatchFileChangeApproval handler, the first file-change request for a turn
+// opens a batch, and every request for the same thread and turn that arrives
+// within window joins it. When the window closes, the batch handler runs once
+// with the params in arrival order, and each response is sent back to its own
+// request.
+//
+// Batching relies on the Transport dispatching server requests concurrently.
+// A transport that dispatches inline, waiting for each response before
+// delivering the next request, only ever forms batches of one, each flushed
+// after window elapses.
+//
+// The batch handler receives the context of the request that opened the batch,
+// so ApprovalInfoFromContext reports that request, but without its deadline or
+// cancellation. A request whose own context ends before its batch is flushed
+// leaves the batch and returns the context error; a request that ends after
+// the flush ignores its response. Close fails every pending request with a
+// CanceledError without running the batch handler. If the handler fails, panics, or
+// returns the wrong number of responses, every request in the batch fails.
+// A window <= 0, or a missing batch handler, leaves file-change approvals on
+// OnFileChangeRequestApproval.
+func WithCoalescedFileApprovals(window time.Duration) ClientOption {
+	return func(c *Client) {
+		c.fileApprovalWindow = window
+	}
+}
+
+type fileApprovalBatch struct {
+	// ctx is the opening request's context, detached from its cancellation.
+	ctx     context.Context
+	handler func(context.Context, []FileChangeRequestApprovalParams) ([]FileChangeRequestApprovalResponse, error)
+	entries []*pendingFileApproval
+	timer   *time.Timer
+}
+
+type pendingFileApproval struct {
+	req    Request
+	params FileChangeRequestApprovalParams
+	done   chan fileApprovalResult
+}
+
+type fileApprovalResult struct {
+	resp Response
+	err  error
+}
+
+func (c *Client) coalesceFileChangeApproval(
+	ctx context.Context,
+	req Request,
+	handler func(context.Context, []FileChangeRequestApprovalParams) ([]FileChangeRequestApprovalResponse, error),
+) (Response, error) {
+	var params FileChangeRequestApprovalParams
+	if err := json.Unmarshal(req.Params, &params); err != nil {
+		return Response{}, fmt.Errorf("unmarshal %s params: %w", req.Method, errors.Join(errInvalidParams, err))
+	}
+
+	entry := &pendingFileApproval{req: req, params: params, done: make(chan fileApprovalResult, 1)}
+	key := params.ThreadID + "\x00" + params.TurnID
+
+	c.fileApprovalMu.Lock()
+	if c.fileApprovalClosed {
+		c.fileApprovalMu.Unlock()
+		return Response{}, errFileApprovalClosed()
+	}
+	if c.fileApprovalBatches == nil {
+		c.fileApprovalBatches = make(map[string]*fileApprovalBatch)
+	}
+	batch, ok := c.fileApprovalBatches[key]
+	if !ok {
+		batch = &fileApprovalBatch{ctx: context.WithoutCancel(ctx), handler: handler}
+		c.fileApprovalBatches[key] = batch
+		batch.timer = time.AfterFunc(c.fileApprovalWindow, func() { c.flushFileApprovalBatch(key, batch) })
+	}
+	batch.entries = append(batch.entries, entry)
+	c.fileApprovalMu.Unlock()
+
+	select {
+	case result := <-entry.done:
+		return result.resp, result.err
+	case <-ctx.Done():
+		c.fileApprovalMu.Lock()
+		if i := slices.Index(batch.entries, entry); i >= 0 && c.fileApprovalBatches[key] == batch {
+			batch.entries = slices.Delete(batch.entries, i, i+1)
+		}
+		c.fileApprovalMu.Unlock()
+		return Response{}, ctx.Err()
+	}
+}
+
+func (c *Client) flushFileApprovalBatch(key string, batch *fileApprovalBatch) {
+	c.fileApprovalMu.Lock()
+	if c.fileApprovalBatches[key] == batch {
+		delete(c.fileApprovalBatches, key)
+	}
+	entries := batch.entries
+	batch.entries = nil
+	c.fileApprovalMu.Unlock()
+
+	if len(entries) == 0 {
+		return
+	}
+
+	params := make([]FileChangeRequestApprovalParams, len(entries))
+	for i, entry := range entries {
+		params[i] = entry.params
+	}
+
+	responses, err := callBatchFileChangeHandler(batch.ctx, batch.handler, params)
+	if err == nil && len(responses) != len(entries) {
+		err = fmt.Errorf("returned %d responses for %d requests", len(responses), len(entries))
+	}
+	for i, entry := range entries {
+		if err != nil {
+			entry.done <- fileApprovalResult{err: fmt.Errorf("approval handler %s failed: %w", entry.req.Method, err)}
+			continue
+		}
+		resp, respErr := approvalResultResponse(entry.req, responses[i])
+		entry.done <- fileApprovalResult{resp: resp, err: respErr}
+	}
+}
+
+// closeFileApprovalBatches stops every pending flush and fails its requests
+// instead of running the batch handler. Later requests fail immediately.
+func (c *Client) closeFileApprovalBatches() {
+	c.fileApprovalMu.Lock()
+	c.fileApprovalClosed = true
+	var entries []*pendingFileApproval
+	for key, batch := range c.fileApprovalBatches {
+		batch.timer.Stop()
+		entries = append(entries, batch.entries...)
+		batch.entries = nil
+		delete(c.fileApprovalBatches, key)
+	}
+	c.fileApprovalMu.Unlock()
+
+	for _, entry := range entries {
+		entry.done <- fileApprovalResult{err: errFileApprovalClosed()}
+	}
+}
+
+func errFileApprovalClosed() error {
+	return NewCanceledError("client closed before file change approval was answered", context.Canceled)
+}
+
+// callBatchFileChangeHandler runs handler off the dispatch path, so a panic
+// must be converted to an error here rather than by handleRequest.
+func callBatchFileChangeHandler(
+	ctx context.Context,
+	handler func(context.Context, []FileChangeRequestApprovalParams) ([]FileChangeRequestApprovalResponse, error),
+	params []FileChangeRequestApprovalParams,
+) (responses []FileChangeRequestApprovalResponse, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = panicToError(r)
+		}
+	}()
+	return handler(ctx, params)
+}
@@ -1,6 +1,10 @@
 package codex
 
-import "context"
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
 
 // ApprovalHandlers contains optional callback functions for all server→client approval requests.
 // If a handler is not set, the client will return a JSON-RPC method-not-found error when
@@ -16,6 +20,115 @@ type ApprovalHandlers struct {
 	OnChatgptAuthTokensRefresh        func(context.Context, ChatgptAuthTokensRefreshParams) (ChatgptAuthTokensRefreshResponse, error)
 	OnMcpServerElicitationRequest     func(context.Context, McpServerElicitationRequestParams) (McpServerElicitationRequestResponse, error)
 	OnAttestationGenerate             func(context.Context, AttestationGenerateParams) (AttestationGenerateResponse, error)
+
+	// OnBatchFileChangeApproval answers file-change approval requests in
+	// batches. It is used instead of OnFileChangeRequestApproval only when the
+	// client was created with WithCoalescedFileApprovals. It must return one
+	// response per params, in the same order.
+	OnBatchFileChangeApproval func(context.Context, []FileChangeRequestApprovalParams) ([]FileChangeRequestApprovalResponse, error)
+}
+
+// ApprovalMetadata describes the server→client request an approval handler is
+// answering. Retrieve it inside a handler with ApprovalInfoFromContext.
+type ApprovalMetadata struct {
+	// RequestID is the JSON-RPC ID of the server's request.
+	RequestID RequestID
+	// Method is the JSON-RPC method of the server's request.
+	Method string
+	// ReceivedAt is when the client received the request from its transport.
+	ReceivedAt time.Time
+}
+
+type approvalMetadataKey struct{}
+
+// ApprovalInfoFromContext returns the metadata of the request being handled
+// when called with the context passed to an approval handler. It returns false
+// for any other context.
+func ApprovalInfoFromContext(ctx context.Context) (ApprovalMetadata, bool) {
+	if ctx == nil {
+		return ApprovalMetadata{}, false
+	}
+	meta, ok := ctx.Value(approvalMetadataKey{}).(ApprovalMetadata)
+	return meta, ok
+}
+
+func withApprovalMetadata(ctx context.Context, req Request, receivedAt time.Time) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, approvalMetadataKey{}, ApprovalMetadata{
+		RequestID:  req.ID,
+		Method:     req.Method,
+		ReceivedAt: receivedAt,
+	})
+}
+
+// overlay returns h with every non-nil handler in scoped replacing the
+// corresponding handler in h.
+func (h ApprovalHandlers) overlay(scoped ApprovalHandlers) ApprovalHandlers {
+	if scoped.OnApplyPatchApproval != nil {
+		h.OnApplyPatchApproval = scoped.OnApplyPatchApproval
+	}
+	if scoped.OnCommandExecutionRequestApproval != nil {
+		h.OnCommandExecutionRequestApproval = scoped.OnCommandExecutionRequestApproval
+	}
+	if scoped.OnExecCommandApproval != nil {
+		h.OnExecCommandApproval = scoped.OnExecCommandApproval
+	}
+	if scoped.OnFileChangeRequestApproval != nil {
+		h.OnFileChangeRequestApproval = scoped.OnFileChangeRequestApproval
+	}
+	if scoped.OnPermissionsRequestApproval != nil {
+		h.OnPermissionsRequestApproval = scoped.OnPermissionsRequestApproval
+	}
+	if scoped.OnDynamicToolCall != nil {
+		h.OnDynamicToolCall = scoped.OnDynamicToolCall
+	}
+	if scoped.OnToolRequestUserInput != nil {
+		h.OnToolRequestUserInput = scoped.OnToolRequestUserInput
+	}
+	if scoped.OnChatgptAuthTokensRefresh != nil {
+		h.OnChatgptAuthTokensRefresh = scoped.OnChatgptAuthTokensRefresh
+	}
+	if scoped.OnMcpServerElicitationRequest != nil {
+		h.OnMcpServerElicitationRequest = scoped.OnMcpServerElicitationRequest
+	}
+	if scoped.OnAttestationGenerate != nil {
+		h.OnAttestationGenerate = scoped.OnAttestationGenerate
+	}
+	if scoped.OnBatchFileChangeApproval != nil {
+		h.OnBatchFileChangeApproval = scoped.OnBatchFileChangeApproval
+	}
+	return h
+}
+
+// scopedApprovalHandlers are approval handlers that apply only to requests
+// for one thread, optionally narrowed to one turn.
+type scopedApprovalHandlers struct {
+	id       uint64
+	threadID string
+	turnID   string
+	handlers ApprovalHandlers
+}
+
+// approvalScope is the thread/turn binding carried by approval request params.
+// Legacy approval requests identify the thread as conversationId and carry no
+// turn ID.
+type approvalScope struct {
+	ThreadID       string `json:"threadId"`
+	TurnID         string `json:"turnId"`
+	ConversationID string `json:"conversationId"`
+}
+
+func decodeApprovalScope(params json.RawMessage) (approvalScope, bool) {
+	var scope approvalScope
+	if len(params) == 0 || json.Unmarshal(params, &scope) != nil {
+		return approvalScope{}, false
+	}
+	if scope.ThreadID == "" {
+		scope.ThreadID = scope.ConversationID
+	}
+	return scope, scope.ThreadID != ""
 }
 
 // SetApprovalHandlers registers approval handlers on the client for server→client requests.
@@ -24,3 +137,85 @@ func (c *Client) SetApprovalHandlers(handlers ApprovalHandlers) {
 	defer c.approvalMu.Unlock()
 	c.approvalHandlers = handlers
 }
+
+// WithApprovalHandlers registers approval handlers while the client is being
+// constructed, before the transport can deliver any server request. It is
+// equivalent to calling SetApprovalHandlers immediately, without the window
+// in which an early approval request would find no handler.
+func WithApprovalHandlers(handlers ApprovalHandlers) ClientOption {
+	return func(c *Client) {
+		c.approvalHandlers = handlers
+	}
+}
+
+// AddScopedApprovalHandlers registers approval handlers that take precedence
+// over the client-level handlers for approval requests belonging to threadID.
+// When turnID is non-empty the override applies only to that turn. Nil fields
+// in handlers fall back to the client-level handlers, so a scope can override
+// just the approval types it cares about.
+//
+// When several scopes match a request, a turn-specific scope wins over a
+// thread-wide one and the most recently added scope wins among equals.
+// Requests that carry no thread binding, such as account token refreshes,
+// always use the client-level handlers. The returned function removes the
+// scope and is safe to call more than once.
+func (c *Client) AddScopedApprovalHandlers(threadID, turnID string, handlers ApprovalHandlers) func() {
+	if threadID == "" {
+		return func() {}
+	}
+	c.approvalMu.Lock()
+	c.scopedApprovalSeq++
+	id := c.scopedApprovalSeq
+	c.scopedApprovals = append(c.scopedApprovals, scopedApprovalHandlers{
+		id:       id,
+		threadID: threadID,
+		turnID:   turnID,
+		handlers: handlers,
+	})
+	c.approvalMu.Unlock()
+
+	return func() {
+		c.approvalMu.Lock()
+		defer c.approvalMu.Unlock()
+		for i, scoped := range c.scopedApprovals {
+			if scoped.id == id {
+				c.scopedApprovals = append(c.scopedApprovals[:i:i], c.scopedApprovals[i+1:]...)
+				break
+			}
+		}
+	}
+}
+
+// approvalHandlersFor returns the effective approval handlers for a request
+// with the given params. Callers must hold approvalMu for reading.
+func (c *Client) approvalHandlersFor(params json.RawMessage) ApprovalHandlers {
+	handlers := c.approvalHandlers
+	if len(c.scopedApprovals) == 0 {
+		return handlers
+	}
+	scope, ok := decodeApprovalScope(params)
+	if !ok {
+		return handlers
+	}
+
+	var threadMatch, turnMatch *scopedApprovalHandlers
+	for i := range c.scopedApprovals {
+		scoped := &c.scopedApprovals[i]
+		if scoped.threadID != scope.ThreadID {
+			continue
+		}
+		switch scoped.turnID {
+		case "":
+			threadMatch = scoped
+		case scope.TurnID:
+			turnMatch = scoped
+		}
+	}
+	if threadMatch != nil {
+		handlers = handlers.overlay(threadMatch.handlers)
+	}
+	if turnMatch != nil {
+		handlers = handlers.overlay(turnMatch.handlers)
+	}
+	return handlers
+}
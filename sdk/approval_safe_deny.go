@@ -0,0 +1,69 @@
+package codex
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// SafeDenyHandler returns approval handlers that refuse every approval
+// request, for locked-down environments that must never guess at a decision.
+// Each handler answers with the decline form of its own response type:
+//
+//   - command, file-change and patch approvals are declined or denied
+//   - permission requests are answered with an empty grant
+//   - dynamic tool calls fail with an explanatory text item
+//   - user-input requests receive no answers
+//   - MCP elicitations are declined
+//
+// Auth token refresh and attestation requests have no decline form, so their
+// handlers are left nil and the client answers them with method-not-found.
+// If log is non-nil it is called with the method and full params of every
+// request before it is denied.
+func SafeDenyHandler(log func(string)) ApprovalHandlers {
+	return ApprovalHandlers{
+		OnApplyPatchApproval: safeDeny[ApplyPatchApprovalParams](log, methodApplyPatchApproval, func() ApplyPatchApprovalResponse {
+			return ApplyPatchApprovalResponse{Decision: ReviewDecisionWrapper{Value: "denied"}}
+		}),
+		OnCommandExecutionRequestApproval: safeDeny[CommandExecutionRequestApprovalParams](log, methodCommandExecutionRequestApproval, func() CommandExecutionRequestApprovalResponse {
+			return CommandExecutionRequestApprovalResponse{Decision: CommandExecutionApprovalDecisionWrapper{Value: CommandExecutionApprovalDecisionDecline}}
+		}),
+		OnExecCommandApproval: safeDeny[ExecCommandApprovalParams](log, methodExecCommandApproval, func() ExecCommandApprovalResponse {
+			return ExecCommandApprovalResponse{Decision: ReviewDecisionWrapper{Value: "denied"}}
+		}),
+		OnFileChangeRequestApproval: safeDeny[FileChangeRequestApprovalParams](log, methodFileChangeRequestApproval, func() FileChangeRequestApprovalResponse {
+			return FileChangeRequestApprovalResponse{Decision: FileChangeApprovalDecisionDecline}
+		}),
+		OnPermissionsRequestApproval: safeDeny[PermissionsRequestApprovalParams](log, methodPermissionsRequestApproval, func() PermissionsRequestApprovalResponse {
+			return PermissionsRequestApprovalResponse{}
+		}),
+		OnDynamicToolCall: safeDeny[DynamicToolCallParams](log, methodDynamicToolCall, func() DynamicToolCallResponse {
+			return DynamicToolCallResponse{
+				Success: false,
+				ContentItems: []DynamicToolCallOutputContentItemWrapper{
+					{Value: &InputTextDynamicToolCallOutputContentItem{Text: "tool call denied by client policy"}},
+				},
+			}
+		}),
+		OnToolRequestUserInput: safeDeny[ToolRequestUserInputParams](log, methodToolRequestUserInput, func() ToolRequestUserInputResponse {
+			return ToolRequestUserInputResponse{Answers: map[string]ToolRequestUserInputAnswer{}}
+		}),
+		OnMcpServerElicitationRequest: safeDeny[McpServerElicitationRequestParams](log, methodMcpServerElicitationRequest, func() McpServerElicitationRequestResponse {
+			return McpServerElicitationRequestResponse{Action: McpServerElicitationActionDecline}
+		}),
+	}
+}
+
+func safeDeny[P any, R any](log func(string), method string, decline func() R) func(context.Context, P) (R, error) {
+	return func(_ context.Context, params P) (R, error) {
+		if log != nil {
+			data, err := json.Marshal(params)
+			if err != nil {
+				log(fmt.Sprintf("denied %s (params not encodable: %v)", method, err))
+			} else {
+				log(fmt.Sprintf("denied %s: %s", method, data))
+			}
+		}
+		return decline(), nil
+	}
+}
@@ -3,9 +3,12 @@ package codex_test
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	codex "github.com/dominicnunez/codex-sdk-go/sdk"
 )
@@ -125,6 +128,102 @@ func TestApplyPatchApprovalRoundTrip(t *testing.T) {
 	}
 }
 
+func TestApplyPatchApprovalParamsValidate(t *testing.T) {
+	update := func(diff string, movePath *string) codex.FileChangeWrapper {
+		return codex.FileChangeWrapper{Value: &codex.UpdateFileChange{UnifiedDiff: diff, MovePath: movePath}}
+	}
+	validDiff := "--- a/main.go\n+++ b/main.go\n@@ -1,2 +1,3 @@\n package main\n+\n func main() {}\n\\ No newline at end of file\n@@ -10 +11,0 @@\n-old\n"
+
+	tests := []struct {
+		name    string
+		changes map[string]codex.FileChangeWrapper
+		want    string
+	}{
+		{
+			name: "valid patch",
+			changes: map[string]codex.FileChangeWrapper{
+				"main.go":  update(validDiff, nil),
+				"new.go":   {Value: &codex.AddFileChange{Content: ""}},
+				"gone.go":  {Value: &codex.DeleteFileChange{Content: "package gone\n"}},
+				"old.go":   update("", strPtr("gone.go")),
+				"same.go":  update("@@ -1 +1 @@\n-a\n+b", strPtr("same.go")),
+				"plain.go": update("@@ -0,0 +1 @@\n+x\n", nil),
+			},
+		},
+		{
+			name:    "empty update",
+			changes: map[string]codex.FileChangeWrapper{"a.go": update("", nil)},
+			want:    `fileChanges["a.go"]: update has neither a diff nor a move_path`,
+		},
+		{
+			name:    "missing hunk",
+			changes: map[string]codex.FileChangeWrapper{"a.go": update("--- a/a.go\n+++ b/a.go\n", nil)},
+			want:    "unified diff has no hunks",
+		},
+		{
+			name:    "garbage before hunk",
+			changes: map[string]codex.FileChangeWrapper{"a.go": update("hello\n@@ -1 +1 @@\n-a\n+b\n", nil)},
+			want:    "unified diff line 1: expected hunk header",
+		},
+		{
+			name:    "malformed hunk header",
+			changes: map[string]codex.FileChangeWrapper{"a.go": update("@@ -x +1 @@\n+b\n", nil)},
+			want:    "malformed hunk header",
+		},
+		{
+			name:    "short hunk",
+			changes: map[string]codex.FileChangeWrapper{"a.go": update("@@ -1,2 +1,2 @@\n a\n", nil)},
+			want:    "hunk is shorter than its header declares",
+		},
+		{
+			name:    "long hunk",
+			changes: map[string]codex.FileChangeWrapper{"a.go": update("@@ -1 +1 @@\n-a\n-b\n+c\n", nil)},
+			want:    "hunk is longer than its header declares",
+		},
+		{
+			name:    "move onto updated path",
+			changes: map[string]codex.FileChangeWrapper{"a.go": update("", strPtr("b.go")), "b.go": update("@@ -1 +1 @@\n-a\n+b\n", nil)},
+			want:    `fileChanges["a.go"]: move_path "b.go" conflicts with another change to that path`,
+		},
+		{
+			name:    "two moves to one target",
+			changes: map[string]codex.FileChangeWrapper{"a.go": update("", strPtr("c.go")), "b.go": update("", strPtr("c.go"))},
+			want:    `fileChanges["b.go"]: move_path "c.go" is also the move target of "a.go"`,
+		},
+		{
+			name:    "empty path",
+			changes: map[string]codex.FileChangeWrapper{"": {Value: &codex.AddFileChange{Content: "x"}}},
+			want:    "fileChanges: empty path",
+		},
+		{
+			name:    "unknown change",
+			changes: map[string]codex.FileChangeWrapper{"a.go": {Value: &codex.UnknownFileChange{Type: "chmod"}}},
+			want:    `cannot validate unknown change type "chmod"`,
+		},
+		{
+			name:    "missing change",
+			changes: map[string]codex.FileChangeWrapper{"a.go": {}},
+			want:    `fileChanges["a.go"]: missing change`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := codex.ApplyPatchApprovalParams{CallID: "call", ConversationID: "conv", FileChanges: tt.changes}
+			err := params.Validate()
+			if tt.want == "" {
+				if err != nil {
+					t.Fatalf("Validate() error = %v; want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("Validate() error = %v; want substring %q", err, tt.want)
+			}
+		})
+	}
+}
+
 func TestNetworkApprovalContextRejectsInvalidProtocol(t *testing.T) {
 	var ctx codex.NetworkApprovalContext
 	err := json.Unmarshal([]byte(`{"host":"example.com","protocol":"ftp"}`), &ctx)
@@ -336,6 +435,161 @@ func TestCommandExecutionRequestApprovalParamsRejectInvalidNetworkPolicyAmendmen
 	}
 }
 
+func TestNetworkPolicyDecisionHelpers(t *testing.T) {
+	t.Run("allow host marshals apply network policy amendment", func(t *testing.T) {
+		decision, err := codex.AllowNetworkHostDecision("api.example.com", codex.NetworkApprovalProtocolHTTPS)
+		if err != nil {
+			t.Fatalf("AllowNetworkHostDecision() error = %v", err)
+		}
+		data, err := json.Marshal(codex.CommandExecutionRequestApprovalResponse{Decision: decision})
+		if err != nil {
+			t.Fatalf("Marshal() error = %v", err)
+		}
+		want := `{"decision":{"applyNetworkPolicyAmendment":{"network_policy_amendment":{"action":"allow","host":"api.example.com"}}}}`
+		if got := string(data); got != want {
+			t.Fatalf("Marshal() = %s; want %s", got, want)
+		}
+	})
+
+	t.Run("deny host", func(t *testing.T) {
+		decision, err := codex.DenyNetworkHostDecision("evil.example.com", codex.NetworkApprovalProtocolSocks5TCP)
+		if err != nil {
+			t.Fatalf("DenyNetworkHostDecision() error = %v", err)
+		}
+		amendment, ok := decision.Value.(codex.ApplyNetworkPolicyAmendmentDecision)
+		if !ok {
+			t.Fatalf("decision type = %T; want ApplyNetworkPolicyAmendmentDecision", decision.Value)
+		}
+		if amendment.NetworkPolicyAmendment.Action != codex.NetworkPolicyRuleActionDeny {
+			t.Fatalf("action = %q; want deny", amendment.NetworkPolicyAmendment.Action)
+		}
+	})
+
+	t.Run("rejects invalid inputs", func(t *testing.T) {
+		if _, err := codex.AllowNetworkHostDecision("", codex.NetworkApprovalProtocolHTTP); err == nil {
+			t.Fatal("expected empty host error")
+		}
+		if _, err := codex.AllowNetworkHostDecision("example.com", "ftp"); err == nil {
+			t.Fatal("expected invalid protocol error")
+		}
+		if _, err := codex.NetworkPolicyDecision("maybe", "example.com", codex.NetworkApprovalProtocolHTTP); err == nil {
+			t.Fatal("expected invalid action error")
+		}
+	})
+
+	t.Run("params use network approval context", func(t *testing.T) {
+		params := codex.CommandExecutionRequestApprovalParams{
+			NetworkApprovalContext: &codex.NetworkApprovalContext{
+				Host:     "registry.npmjs.org",
+				Protocol: codex.NetworkApprovalProtocolHTTPS,
+			},
+		}
+		decision, err := params.NetworkPolicyDecision(codex.NetworkPolicyRuleActionAllow)
+		if err != nil {
+			t.Fatalf("NetworkPolicyDecision() error = %v", err)
+		}
+		amendment := decision.Value.(codex.ApplyNetworkPolicyAmendmentDecision)
+		if amendment.NetworkPolicyAmendment.Host != "registry.npmjs.org" {
+			t.Fatalf("host = %q; want registry.npmjs.org", amendment.NetworkPolicyAmendment.Host)
+		}
+
+		if _, err := (codex.CommandExecutionRequestApprovalParams{}).NetworkPolicyDecision(codex.NetworkPolicyRuleActionAllow); err == nil {
+			t.Fatal("expected missing network approval context error")
+		}
+	})
+}
+
+func TestDescribeCommandAction(t *testing.T) {
+	tests := []struct {
+		name   string
+		action codex.CommandActionWrapper
+		legacy codex.ParsedCommandWrapper
+		want   string
+	}{
+		{
+			name:   "read",
+			action: codex.CommandActionWrapper{Value: &codex.ReadCommandAction{Command: "cat src/main.go", Name: "main.go", Path: "src/main.go"}},
+			legacy: codex.ParsedCommandWrapper{Value: &codex.ReadParsedCommand{Cmd: "cat src/main.go", Name: "main.go", Path: "src/main.go"}},
+			want:   "Read file src/main.go",
+		},
+		{
+			name:   "list files",
+			action: codex.CommandActionWrapper{Value: &codex.ListFilesCommandAction{Command: "ls /repo", Path: strPtr("/repo")}},
+			legacy: codex.ParsedCommandWrapper{Value: &codex.ListFilesParsedCommand{Cmd: "ls /repo", Path: strPtr("/repo")}},
+			want:   "List files in /repo",
+		},
+		{
+			name:   "list files without path",
+			action: codex.CommandActionWrapper{Value: &codex.ListFilesCommandAction{Command: "ls"}},
+			legacy: codex.ParsedCommandWrapper{Value: &codex.ListFilesParsedCommand{Cmd: "ls"}},
+			want:   "List files",
+		},
+		{
+			name:   "search",
+			action: codex.CommandActionWrapper{Value: &codex.SearchCommandAction{Command: "rg foo /repo", Query: strPtr("foo"), Path: strPtr("/repo")}},
+			legacy: codex.ParsedCommandWrapper{Value: &codex.SearchParsedCommand{Cmd: "rg foo /repo", Query: strPtr("foo"), Path: strPtr("/repo")}},
+			want:   "Search for 'foo' in /repo",
+		},
+		{
+			name:   "search without path",
+			action: codex.CommandActionWrapper{Value: &codex.SearchCommandAction{Command: "rg foo", Query: strPtr("foo")}},
+			legacy: codex.ParsedCommandWrapper{Value: &codex.SearchParsedCommand{Cmd: "rg foo", Query: strPtr("foo")}},
+			want:   "Search for 'foo'",
+		},
+		{
+			name:   "unknown",
+			action: codex.CommandActionWrapper{Value: &codex.UnknownCommandAction{Command: "make test"}},
+			legacy: codex.ParsedCommandWrapper{Value: &codex.UnknownParsedCommand{Cmd: "make test"}},
+			want:   "Run make test",
+		},
+		{
+			name: "empty wrapper",
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := codex.DescribeCommandAction(tt.action); got != tt.want {
+				t.Fatalf("DescribeCommandAction() = %q; want %q", got, tt.want)
+			}
+			if got := codex.DescribeParsedCommand(tt.legacy); got != tt.want {
+				t.Fatalf("DescribeParsedCommand() = %q; want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCommandExecutionRequestApprovalParamsSummarizeAndPaths(t *testing.T) {
+	params := codex.CommandExecutionRequestApprovalParams{
+		Command: strPtr("cat src/main.go && rg foo /repo"),
+		CommandActions: &[]codex.CommandActionWrapper{
+			{Value: &codex.ReadCommandAction{Command: "cat src/main.go", Name: "main.go", Path: "src/main.go"}},
+			{Value: &codex.SearchCommandAction{Command: "rg foo /repo", Query: strPtr("foo"), Path: strPtr("/repo")}},
+			{Value: &codex.ListFilesCommandAction{Command: "ls /repo", Path: strPtr("/repo")}},
+			{Value: &codex.UnknownCommandAction{Command: "make"}},
+		},
+	}
+
+	if got, want := params.Summarize(), "Read file src/main.go, search for 'foo' in /repo, list files in /repo, run make"; got != want {
+		t.Fatalf("Summarize() = %q; want %q", got, want)
+	}
+	if got := strings.Join(params.Paths(), ","); got != "src/main.go,/repo" {
+		t.Fatalf("Paths() = %q; want src/main.go,/repo", got)
+	}
+
+	rawOnly := codex.CommandExecutionRequestApprovalParams{Command: strPtr("make test")}
+	if got := rawOnly.Summarize(); got != "Run make test" {
+		t.Fatalf("Summarize() without actions = %q; want raw command", got)
+	}
+	if got := rawOnly.Paths(); got != nil {
+		t.Fatalf("Paths() without actions = %v; want nil", got)
+	}
+	if got := (codex.CommandExecutionRequestApprovalParams{}).Summarize(); got != "" {
+		t.Fatalf("Summarize() on empty params = %q; want empty", got)
+	}
+}
+
 func TestApprovalParamsNormalizeAndValidatePaths(t *testing.T) {
 	t.Run("command execution approval normalizes action paths against cwd", func(t *testing.T) {
 		var params codex.CommandExecutionRequestApprovalParams
@@ -1016,6 +1270,356 @@ func TestApprovalHandlerDispatch(t *testing.T) {
 	}
 }
 
+func TestScopedApprovalHandlersRouteConcurrentTurns(t *testing.T) {
+	mock := NewMockTransport()
+	client := codex.NewClient(mock)
+
+	client.SetApprovalHandlers(codex.ApprovalHandlers{
+		OnCommandExecutionRequestApproval: func(context.Context, codex.CommandExecutionRequestApprovalParams) (codex.CommandExecutionRequestApprovalResponse, error) {
+			return codex.CommandExecutionRequestApprovalResponse{
+				Decision: codex.CommandExecutionApprovalDecisionWrapper{Value: codex.CommandExecutionApprovalDecisionCancel},
+			}, nil
+		},
+		OnFileChangeRequestApproval: func(context.Context, codex.FileChangeRequestApprovalParams) (codex.FileChangeRequestApprovalResponse, error) {
+			return codex.FileChangeRequestApprovalResponse{Decision: codex.FileChangeApprovalDecisionDecline}, nil
+		},
+	})
+
+	commandDecisionFor := func(decision string) codex.ApprovalHandlers {
+		return codex.ApprovalHandlers{
+			OnCommandExecutionRequestApproval: func(_ context.Context, p codex.CommandExecutionRequestApprovalParams) (codex.CommandExecutionRequestApprovalResponse, error) {
+				return codex.CommandExecutionRequestApprovalResponse{
+					Decision: codex.CommandExecutionApprovalDecisionWrapper{Value: decision},
+				}, nil
+			},
+		}
+	}
+	removeA := client.AddScopedApprovalHandlers("thread-a", "turn-a", commandDecisionFor(codex.CommandExecutionApprovalDecisionAccept))
+	defer removeA()
+	removeB := client.AddScopedApprovalHandlers("thread-b", "", commandDecisionFor(codex.CommandExecutionApprovalDecisionDecline))
+	defer removeB()
+
+	commandRequest := func(id int, threadID, turnID string) codex.Request {
+		return codex.Request{
+			JSONRPC: "2.0",
+			ID:      codex.RequestID{Value: id},
+			Method:  "item/commandExecution/requestApproval",
+			Params:  json.RawMessage(fmt.Sprintf(`{"itemId":"item","startedAtMs":1,"threadId":%q,"turnId":%q}`, threadID, turnID)),
+		}
+	}
+
+	tests := []struct {
+		name string
+		req  codex.Request
+		want string
+	}{
+		{name: "turn scope", req: commandRequest(1, "thread-a", "turn-a"), want: `{"decision":"accept"}`},
+		{name: "thread scope", req: commandRequest(2, "thread-b", "turn-x"), want: `{"decision":"decline"}`},
+		{name: "other turn on scoped thread falls back", req: commandRequest(3, "thread-a", "turn-other"), want: `{"decision":"cancel"}`},
+		{name: "unscoped thread falls back", req: commandRequest(4, "thread-c", "turn-c"), want: `{"decision":"cancel"}`},
+		{
+			name: "unset scoped handler falls back",
+			req: codex.Request{
+				JSONRPC: "2.0",
+				ID:      codex.RequestID{Value: 5},
+				Method:  "item/fileChange/requestApproval",
+				Params:  json.RawMessage(`{"itemId":"item","startedAtMs":1,"threadId":"thread-a","turnId":"turn-a"}`),
+			},
+			want: `{"decision":"decline"}`,
+		},
+	}
+
+	var wg sync.WaitGroup
+	results := make([]codex.Response, len(tests))
+	errs := make([]error, len(tests))
+	for i, tt := range tests {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i], errs[i] = mock.InjectServerRequest(context.Background(), tt.req)
+		}()
+	}
+	wg.Wait()
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if errs[i] != nil {
+				t.Fatalf("InjectServerRequest() error = %v", errs[i])
+			}
+			if got := string(results[i].Result); got != tt.want {
+				t.Fatalf("result = %s; want %s", got, tt.want)
+			}
+		})
+	}
+
+	removeA()
+	resp, err := mock.InjectServerRequest(context.Background(), commandRequest(6, "thread-a", "turn-a"))
+	if err != nil {
+		t.Fatalf("InjectServerRequest() after remove error = %v", err)
+	}
+	if got := string(resp.Result); got != `{"decision":"cancel"}` {
+		t.Fatalf("result after remove = %s; want client-level decision", got)
+	}
+}
+
+func TestApprovalInfoFromContext(t *testing.T) {
+	mock := NewMockTransport()
+	client := codex.NewClient(mock)
+
+	var (
+		got   codex.ApprovalMetadata
+		found bool
+	)
+	client.SetApprovalHandlers(codex.ApprovalHandlers{
+		OnFileChangeRequestApproval: func(ctx context.Context, _ codex.FileChangeRequestApprovalParams) (codex.FileChangeRequestApprovalResponse, error) {
+			got, found = codex.ApprovalInfoFromContext(ctx)
+			return codex.FileChangeRequestApprovalResponse{Decision: codex.FileChangeApprovalDecisionAccept}, nil
+		},
+	})
+
+	before := time.Now()
+	_, err := mock.InjectServerRequest(context.Background(), codex.Request{
+		JSONRPC: "2.0",
+		ID:      codex.RequestID{Value: "srv-42"},
+		Method:  "item/fileChange/requestApproval",
+		Params:  json.RawMessage(`{"itemId":"item","startedAtMs":1,"threadId":"thread","turnId":"turn"}`),
+	})
+	if err != nil {
+		t.Fatalf("InjectServerRequest() error = %v", err)
+	}
+
+	if !found {
+		t.Fatal("ApprovalInfoFromContext() found no metadata in handler context")
+	}
+	if !got.RequestID.Equal(codex.RequestID{Value: "srv-42"}) {
+		t.Fatalf("RequestID = %v; want srv-42", got.RequestID.Value)
+	}
+	if got.Method != "item/fileChange/requestApproval" {
+		t.Fatalf("Method = %q; want item/fileChange/requestApproval", got.Method)
+	}
+	if got.ReceivedAt.Before(before) || got.ReceivedAt.After(time.Now()) {
+		t.Fatalf("ReceivedAt = %v; want time of receipt", got.ReceivedAt)
+	}
+
+	if _, ok := codex.ApprovalInfoFromContext(context.Background()); ok {
+		t.Fatal("ApprovalInfoFromContext() found metadata in a plain context")
+	}
+}
+
+func TestCoalescedFileChangeApprovals(t *testing.T) {
+	mock := NewMockTransport()
+	client := codex.NewClient(mock, codex.WithCoalescedFileApprovals(50*time.Millisecond))
+
+	var (
+		mu      sync.Mutex
+		batches [][]string
+	)
+	client.SetApprovalHandlers(codex.ApprovalHandlers{
+		OnFileChangeRequestApproval: func(context.Context, codex.FileChangeRequestApprovalParams) (codex.FileChangeRequestApprovalResponse, error) {
+			t.Error("single-request handler called while batching is enabled")
+			return codex.FileChangeRequestApprovalResponse{Decision: codex.FileChangeApprovalDecisionCancel}, nil
+		},
+		OnBatchFileChangeApproval: func(_ context.Context, params []codex.FileChangeRequestApprovalParams) ([]codex.FileChangeRequestApprovalResponse, error) {
+			items := make([]string, len(params))
+			responses := make([]codex.FileChangeRequestApprovalResponse, len(params))
+			for i, p := range params {
+				items[i] = p.ItemID
+				responses[i].Decision = codex.FileChangeApprovalDecisionAccept
+				if p.ItemID == "item-2" {
+					responses[i].Decision = codex.FileChangeApprovalDecisionDecline
+				}
+			}
+			mu.Lock()
+			batches = append(batches, items)
+			mu.Unlock()
+			return responses, nil
+		},
+	})
+
+	fileRequest := func(id int, itemID, turnID string) codex.Request {
+		return codex.Request{
+			JSONRPC: "2.0",
+			ID:      codex.RequestID{Value: id},
+			Method:  "item/fileChange/requestApproval",
+			Params:  json.RawMessage(fmt.Sprintf(`{"itemId":%q,"startedAtMs":1,"threadId":"thread","turnId":%q}`, itemID, turnID)),
+		}
+	}
+	requests := []codex.Request{
+		fileRequest(1, "item-1", "turn-a"),
+		fileRequest(2, "item-2", "turn-a"),
+		fileRequest(3, "item-3", "turn-a"),
+		fileRequest(4, "item-4", "turn-b"),
+	}
+
+	var wg sync.WaitGroup
+	results := make([]codex.Response, len(requests))
+	errs := make([]error, len(requests))
+	for i, req := range requests {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i], errs[i] = mock.InjectServerRequest(context.Background(), req)
+		}()
+	}
+	wg.Wait()
+
+	want := []string{`{"decision":"accept"}`, `{"decision":"decline"}`, `{"decision":"accept"}`, `{"decision":"accept"}`}
+	for i := range requests {
+		if errs[i] != nil {
+			t.Fatalf("request %d error = %v", i+1, errs[i])
+		}
+		if !results[i].ID.Equal(requests[i].ID) {
+			t.Fatalf("request %d response ID = %v", i+1, results[i].ID.Value)
+		}
+		if got := string(results[i].Result); got != want[i] {
+			t.Fatalf("request %d result = %s; want %s", i+1, got, want[i])
+		}
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(batches) != 2 {
+		t.Fatalf("batch handler calls = %v; want one batch per turn", batches)
+	}
+	for _, batch := range batches {
+		if len(batch) != 3 && !(len(batch) == 1 && batch[0] == "item-4") {
+			t.Fatalf("unexpected batch %v", batch)
+		}
+	}
+}
+
+func TestCoalescedFileChangeApprovalsRejectMismatchedResponseCount(t *testing.T) {
+	mock := NewMockTransport()
+	client := codex.NewClient(mock, codex.WithCoalescedFileApprovals(time.Millisecond))
+	client.SetApprovalHandlers(codex.ApprovalHandlers{
+		OnBatchFileChangeApproval: func(ctx context.Context, _ []codex.FileChangeRequestApprovalParams) ([]codex.FileChangeRequestApprovalResponse, error) {
+			meta, ok := codex.ApprovalInfoFromContext(ctx)
+			if !ok || meta.Method != "item/fileChange/requestApproval" || !meta.RequestID.Equal(codex.RequestID{Value: 1}) {
+				t.Errorf("ApprovalInfoFromContext() = %+v, %v; want the opening request's metadata", meta, ok)
+			}
+			return nil, nil
+		},
+	})
+
+	_, err := mock.InjectServerRequest(context.Background(), codex.Request{
+		JSONRPC: "2.0",
+		ID:      codex.RequestID{Value: 1},
+		Method:  "item/fileChange/requestApproval",
+		Params:  json.RawMessage(`{"itemId":"item","startedAtMs":1,"threadId":"thread","turnId":"turn"}`),
+	})
+	if err == nil || !strings.Contains(err.Error(), "returned 0 responses for 1 requests") {
+		t.Fatalf("InjectServerRequest() error = %v; want response count mismatch", err)
+	}
+}
+
+func TestCoalescedFileChangeApprovalsFailPendingRequestsOnClose(t *testing.T) {
+	mock := NewMockTransport()
+	client := codex.NewClient(mock, codex.WithCoalescedFileApprovals(100*time.Millisecond))
+	handlerCalled := make(chan struct{}, 1)
+	client.SetApprovalHandlers(codex.ApprovalHandlers{
+		OnBatchFileChangeApproval: func(context.Context, []codex.FileChangeRequestApprovalParams) ([]codex.FileChangeRequestApprovalResponse, error) {
+			handlerCalled <- struct{}{}
+			return nil, nil
+		},
+	})
+
+	request := codex.Request{
+		JSONRPC: "2.0",
+		ID:      codex.RequestID{Value: 1},
+		Method:  "item/fileChange/requestApproval",
+		Params:  json.RawMessage(`{"itemId":"item","startedAtMs":1,"threadId":"thread","turnId":"turn"}`),
+	}
+	done := make(chan error, 1)
+	go func() {
+		_, err := mock.InjectServerRequest(context.Background(), request)
+		done <- err
+	}()
+
+	time.Sleep(20 * time.Millisecond)
+	if err := client.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	var canceledErr *codex.CanceledError
+	select {
+	case err := <-done:
+		if !errors.As(err, &canceledErr) {
+			t.Fatalf("pending request error = %v; want *codex.CanceledError", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("pending request was not failed by Close")
+	}
+
+	request.ID = codex.RequestID{Value: 2}
+	if _, err := mock.InjectServerRequest(context.Background(), request); !errors.As(err, &canceledErr) {
+		t.Fatalf("request after Close error = %v; want *codex.CanceledError", err)
+	}
+
+	select {
+	case <-handlerCalled:
+		t.Fatal("batch handler ran after Close")
+	case <-time.After(200 * time.Millisecond):
+	}
+}
+
+func TestSafeDenyHandlerDeclinesEveryApproval(t *testing.T) {
+	mock := NewMockTransport()
+	client := codex.NewClient(mock)
+
+	var logged []string
+	client.SetApprovalHandlers(codex.SafeDenyHandler(func(line string) {
+		logged = append(logged, line)
+	}))
+
+	tests := []struct {
+		method string
+		params string
+		want   string
+	}{
+		{method: "applyPatchApproval", params: `{"callId":"c1","conversationId":"t1","fileChanges":{}}`, want: `{"decision":"denied"}`},
+		{method: "item/commandExecution/requestApproval", params: `{"itemId":"i1","startedAtMs":1,"threadId":"t1","turnId":"tu1"}`, want: `{"decision":"decline"}`},
+		{method: "execCommandApproval", params: `{"callId":"c1","conversationId":"t1","command":["ls"],"cwd":"/","parsedCmd":[]}`, want: `{"decision":"denied"}`},
+		{method: "item/fileChange/requestApproval", params: `{"itemId":"i1","startedAtMs":1,"threadId":"t1","turnId":"tu1"}`, want: `{"decision":"decline"}`},
+		{method: "item/tool/call", params: `{"tool":"test","arguments":{},"callId":"c1","threadId":"t1","turnId":"tu1"}`, want: `{"success":false,"contentItems":[{"type":"inputText","text":"tool call denied by client policy"}]}`},
+		{method: "item/tool/requestUserInput", params: `{"itemId":"i1","threadId":"t1","turnId":"tu1","questions":[{"id":"q1","header":"H","question":"Q"}]}`, want: `{"answers":{}}`},
+		{method: "item/permissions/requestApproval", params: `{"cwd":"/tmp","itemId":"i1","permissions":{"network":{"enabled":true}},"startedAtMs":1,"threadId":"t1","turnId":"tu1"}`, want: `{"permissions":{}}`},
+		{method: "mcpServer/elicitation/request", params: `{"serverName":"demo","threadId":"t1","message":"Need input","mode":"url","elicitationId":"e1","url":"https://example.com"}`, want: `{"action":"decline"}`},
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			resp, err := mock.InjectServerRequest(context.Background(), codex.Request{
+				JSONRPC: "2.0",
+				ID:      codex.RequestID{Value: i + 1},
+				Method:  tt.method,
+				Params:  json.RawMessage(tt.params),
+			})
+			if err != nil {
+				t.Fatalf("InjectServerRequest() error = %v", err)
+			}
+			if got := string(resp.Result); got != tt.want {
+				t.Fatalf("result = %s; want %s", got, tt.want)
+			}
+			if len(logged) != i+1 || !strings.HasPrefix(logged[i], "denied "+tt.method+": {") {
+				t.Fatalf("log lines = %q; want denial of %s with params", logged, tt.method)
+			}
+		})
+	}
+
+	resp, err := mock.InjectServerRequest(context.Background(), codex.Request{
+		JSONRPC: "2.0",
+		ID:      codex.RequestID{Value: 99},
+		Method:  "account/chatgptAuthTokens/refresh",
+		Params:  json.RawMessage(`{"reason":"unauthorized"}`),
+	})
+	if err != nil {
+		t.Fatalf("InjectServerRequest() error = %v", err)
+	}
+	if resp.Error == nil || resp.Error.Code != codex.ErrCodeMethodNotFound {
+		t.Fatalf("auth refresh response = %+v; want method-not-found", resp)
+	}
+}
+
 // TestMissingApprovalHandler tests that missing handlers return method-not-found error
 func TestMissingApprovalHandler(t *testing.T) {
 	mock := NewMockTransport()
@@ -1252,3 +1856,132 @@ func TestDynamicToolCallOutputContentItemWrapperRejectsMalformedPayloads(t *test
 		})
 	}
 }
+
+func TestApprovalParamsRequestedGrantRoot(t *testing.T) {
+	var fileChange codex.FileChangeRequestApprovalParams
+	if err := json.Unmarshal([]byte(`{"itemId":"i","startedAtMs":1,"threadId":"t","turnId":"u","grantRoot":"/repo"}`), &fileChange); err != nil {
+		t.Fatalf("unmarshal file change params: %v", err)
+	}
+	if root, ok := fileChange.RequestedGrantRoot(); !ok || root != "/repo" {
+		t.Errorf("FileChangeRequestApprovalParams.RequestedGrantRoot() = %q, %v; want /repo, true", root, ok)
+	}
+
+	var patch codex.ApplyPatchApprovalParams
+	if err := json.Unmarshal([]byte(`{"callId":"c","conversationId":"t","fileChanges":{}}`), &patch); err != nil {
+		t.Fatalf("unmarshal apply patch params: %v", err)
+	}
+	if root, ok := patch.RequestedGrantRoot(); ok || root != "" {
+		t.Errorf("ApplyPatchApprovalParams.RequestedGrantRoot() = %q, %v; want no grant root", root, ok)
+	}
+}
+
+func TestNetworkApprovalContextString(t *testing.T) {
+	tests := []struct {
+		protocol codex.NetworkApprovalProtocol
+		want     string
+	}{
+		{codex.NetworkApprovalProtocolHTTPS, "connect to api.example.com over HTTPS"},
+		{codex.NetworkApprovalProtocolSocks5UDP, "connect to api.example.com over SOCKS5 UDP"},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.protocol), func(t *testing.T) {
+			ctx := codex.NetworkApprovalContext{Host: "api.example.com", Protocol: tt.protocol}
+			if got := ctx.String(); got != tt.want {
+				t.Errorf("String() = %q; want %q", got, tt.want)
+			}
+		})
+	}
+
+	var params codex.CommandExecutionRequestApprovalParams
+	if err := json.Unmarshal([]byte(`{"itemId":"i","startedAtMs":1,"threadId":"t","turnId":"u",`+
+		`"networkApprovalContext":{"host":"api.example.com","protocol":"http"}}`), &params); err != nil {
+		t.Fatalf("unmarshal params: %v", err)
+	}
+	if !params.RequestsNetwork() {
+		t.Fatal("RequestsNetwork() = false; want true with a network approval context")
+	}
+	if got := params.NetworkApprovalContext.String(); got != "connect to api.example.com over HTTP" {
+		t.Errorf("String() = %q", got)
+	}
+	if (codex.CommandExecutionRequestApprovalParams{}).RequestsNetwork() {
+		t.Error("RequestsNetwork() = true; want false without a network approval context")
+	}
+}
+
+func TestAutoApproveReadsHandlerApprovesOnlyReadCommands(t *testing.T) {
+	tests := []struct {
+		name      string
+		parsedCmd string
+		want      string
+	}{
+		{name: "read", parsedCmd: `[{"type":"read","cmd":"cat a.go","name":"a.go","path":"/repo/a.go"}]`, want: "approved"},
+		{name: "list files", parsedCmd: `[{"type":"list_files","cmd":"ls","path":"/repo"}]`, want: "approved"},
+		{name: "search", parsedCmd: `[{"type":"search","cmd":"rg foo","query":"foo","path":"/repo"}]`, want: "approved"},
+		{
+			name:      "read piped into search",
+			parsedCmd: `[{"type":"read","cmd":"cat a.go","name":"a.go","path":"/repo/a.go"},{"type":"search","cmd":"rg foo","query":"foo"}]`,
+			want:      "approved",
+		},
+		{name: "unknown", parsedCmd: `[{"type":"unknown","cmd":"rm -rf build"}]`, want: "denied"},
+		{
+			name:      "read with unknown part",
+			parsedCmd: `[{"type":"read","cmd":"cat a.go","name":"a.go","path":"/repo/a.go"},{"type":"unknown","cmd":"tee b.go"}]`,
+			want:      "denied",
+		},
+		{name: "no parsed commands", parsedCmd: `[]`, want: "denied"},
+	}
+
+	handler := codex.AutoApproveReadsHandler()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var params codex.ExecCommandApprovalParams
+			data := `{"callId":"c","command":["sh"],"conversationId":"t","cwd":"/repo","parsedCmd":` + tt.parsedCmd + `}`
+			if err := json.Unmarshal([]byte(data), &params); err != nil {
+				t.Fatalf("unmarshal params: %v", err)
+			}
+			resp, err := handler(context.Background(), params)
+			if err != nil {
+				t.Fatalf("handler error = %v", err)
+			}
+			if resp.Decision.Value != tt.want {
+				t.Errorf("decision = %v; want %q", resp.Decision.Value, tt.want)
+			}
+		})
+	}
+}
+
+func TestDynamicToolCallParamsUnmarshalArguments(t *testing.T) {
+	var params codex.DynamicToolCallParams
+	data := `{"tool":"lookup","callId":"c","threadId":"t","turnId":"u","arguments":{"id":42,"tags":["a","b"]}}`
+	if err := json.Unmarshal([]byte(data), &params); err != nil {
+		t.Fatalf("unmarshal params: %v", err)
+	}
+
+	var args struct {
+		ID   int64    `json:"id"`
+		Tags []string `json:"tags"`
+	}
+	if err := params.UnmarshalArguments(&args); err != nil {
+		t.Fatalf("UnmarshalArguments() error = %v", err)
+	}
+	if args.ID != 42 || len(args.Tags) != 2 || args.Tags[1] != "b" {
+		t.Errorf("decoded arguments = %+v; want id 42 and tags [a b]", args)
+	}
+	if got := string(params.RawArguments()); got != `{"id":42,"tags":["a","b"]}` {
+		t.Errorf("RawArguments() = %s; want the received arguments", got)
+	}
+
+	params.Arguments = map[string]any{"id": 7}
+	if got := string(params.RawArguments()); got != `{"id":7}` {
+		t.Errorf("RawArguments() after changing Arguments = %s; want {\"id\":7}", got)
+	}
+
+	built := codex.DynamicToolCallParams{Tool: "lookup", Arguments: map[string]any{"id": 1}}
+	if got := string(built.RawArguments()); got != `{"id":1}` {
+		t.Errorf("RawArguments() for constructed params = %s; want marshaled Arguments", got)
+	}
+	mismatched := codex.DynamicToolCallParams{Tool: "lookup", Arguments: map[string]any{"id": "not a number"}}
+	if err := mismatched.UnmarshalArguments(&args); err == nil {
+		t.Error("UnmarshalArguments() into a mismatched type should fail")
+	}
+}
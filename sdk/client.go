@@ -7,6 +7,9 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"runtime/debug"
+	"slices"
+	"strconv"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -18,6 +21,10 @@ var errInvalidParams = ErrInvalidParams
 // missing or null result where the caller expected a value.
 var ErrEmptyResult = errors.New("server returned empty result")
 
+// ErrRequestRejected indicates a request interceptor installed with
+// WithRequestInterceptor aborted the request before it was sent.
+var ErrRequestRejected = errors.New("request rejected by interceptor")
+
 // ErrResultNotObject indicates the server returned a successful response whose
 // result was not a JSON object where the protocol requires one.
 var ErrResultNotObject = errors.New("server returned non-object result")
@@ -150,6 +157,9 @@ func validateDecodedResponse(result interface{}) error {
 type internalListener struct {
 	id      uint64
 	handler NotificationHandler
+	// registered marks listeners added by the caller through the public API,
+	// as opposed to the client's own bookkeeping.
+	registered bool
 }
 
 type threadStateListener struct {
@@ -187,7 +197,11 @@ type Client struct {
 	// Internal notification listeners: method → list of listeners (append semantics)
 	internalListeners   map[string][]internalListener
 	internalListenerSeq uint64
-	listenersMu         sync.RWMutex
+	// Notification methods to dispatch; nil allows all (set once during construction).
+	notificationAllowlist map[string]struct{}
+	// Optional observer for notification methods this SDK has no type for.
+	unknownNotificationHandler func(method string, params json.RawMessage)
+	listenersMu                sync.RWMutex
 
 	// Best-effort latest thread snapshots keyed by thread ID. This is updated
 	// from thread-bearing responses and thread metadata notifications so
@@ -202,16 +216,68 @@ type Client struct {
 	threadStateListenerSeq uint64
 	threadStateMu          sync.RWMutex
 
-	// Approval handlers for server→client requests
-	approvalHandlers ApprovalHandlers
-	approvalMu       sync.RWMutex
-
-	// Request ID counter for generating unique request IDs
+	// Approval handlers for server→client requests. Scoped handlers override
+	// the client-level handlers for requests bound to a specific thread/turn.
+	approvalHandlers  ApprovalHandlers
+	scopedApprovals   []scopedApprovalHandlers
+	scopedApprovalSeq uint64
+	approvalMu        sync.RWMutex
+
+	// Latest token usage per thread, bounded by maxTrackedThreadUsages.
+	threadUsages     map[string]ThreadTokenUsage
+	threadUsageOrder []string
+	threadUsageMu    sync.Mutex
+
+	// Server request IDs reported by serverRequest/resolved, bounded by
+	// maxTrackedResolvedRequests.
+	resolvedRequests     map[string]struct{}
+	resolvedRequestOrder []string
+	resolvedRequestsMu   sync.Mutex
+
+	// configWarning notifications received so far, bounded by maxConfigWarnings.
+	configWarnings   []ConfigWarningNotification
+	configWarningsMu sync.Mutex
+
+	// Coalescing of file-change approvals (see WithCoalescedFileApprovals).
+	fileApprovalWindow  time.Duration
+	fileApprovalBatches map[string]*fileApprovalBatch
+	fileApprovalClosed  bool
+	fileApprovalMu      sync.Mutex
+
+	// Rate limit holds for turn/start keyed by limitId (see
+	// WithRateLimitAwareness); rateLimitAware is set once during construction.
+	rateLimitAware bool
+	rateLimitHolds map[string]time.Time
+	// rateLimitChanged is closed and replaced whenever the holds change, to
+	// wake turn/start requests waiting in waitForRateLimitReset.
+	rateLimitChanged chan struct{}
+	rateLimitMu      sync.Mutex
+
+	// Per-method request latency, reported by MethodStats.
+	methodStats   map[string]*methodLatencies
+	methodStatsMu sync.Mutex
+
+	// Request ID counter for generating unique request IDs, optionally
+	// namespaced by requestIDPrefix (set once during construction).
 	requestIDCounter atomic.Uint64
+	requestIDPrefix  string
+
+	// Events dropped by full SystemEvents channels.
+	droppedSystemEvents atomic.Uint64
+
+	// Recent handler errors and unknown notifications for DiagnosticsDump.
+	diagnostics clientDiagnostics
 
 	// Handler error callback (optional, set once during construction)
 	handlerErrorCallback func(method string, err error)
 
+	// Whether sandbox roots are checked on disk before sending (set once
+	// during construction; see WithPathValidation).
+	pathValidation bool
+
+	// Outgoing request params hook (optional, set once during construction)
+	requestInterceptor func(method string, params json.RawMessage) (json.RawMessage, error)
+
 	// Service accessors
 	Thread          *ThreadService
 	Turn            *TurnService
@@ -248,14 +314,76 @@ func WithRequestTimeout(timeout time.Duration) ClientOption {
 
 // WithHandlerErrorCallback sets a callback that is invoked when a notification
 // handler or approval handler panics or returns an error. The callback receives
-// the JSON-RPC method name and the error. If the callback itself panics, the
-// panic is silently recovered.
+// the JSON-RPC method name and the error. Notification handler panics are
+// reported as a *HandlerError with the redacted params and a stack trace. If
+// the callback itself panics, the panic is silently recovered.
 func WithHandlerErrorCallback(cb func(method string, err error)) ClientOption {
 	return func(c *Client) {
 		c.handlerErrorCallback = cb
 	}
 }
 
+// WithIDPrefix namespaces the IDs of requests sent by the client, so IDs take
+// the form "<prefix>:1", "<prefix>:2", and so on instead of bare integers.
+// This keeps IDs unique when several clients share one underlying stream and
+// makes requests attributable in logs. Prefixed IDs are sent as JSON strings;
+// responses are matched against them by string comparison. An empty prefix
+// keeps the default numeric IDs.
+func WithIDPrefix(prefix string) ClientOption {
+	return func(c *Client) {
+		c.requestIDPrefix = prefix
+	}
+}
+
+// WithNotificationAllowlist restricts the client to dispatching notifications
+// whose method is listed. Notifications for other methods are dropped before
+// their params are decoded, so neither listeners nor the client's own
+// bookkeeping (thread state snapshots, ThreadUsage, ConfigWarnings) see them.
+// Repeated uses add to the allowlist. Without this option, or with no methods,
+// every notification is dispatched. To stop the server from sending a method
+// at all, use InitializeCapabilities.OptOutNotificationMethods instead.
+func WithNotificationAllowlist(methods ...string) ClientOption {
+	return func(c *Client) {
+		if len(methods) == 0 {
+			return
+		}
+		if c.notificationAllowlist == nil {
+			c.notificationAllowlist = make(map[string]struct{}, len(methods))
+		}
+		for _, method := range methods {
+			c.notificationAllowlist[method] = struct{}{}
+		}
+	}
+}
+
+// WithRequestInterceptor installs a hook that runs on every request sent
+// through Send, including those made by the service methods. It receives the
+// method and a copy of the marshaled params exactly as they will go on the
+// wire, and returns the params to send instead; returning them unchanged
+// passes the request through. A non-nil error aborts the request before it
+// reaches the transport, and Send returns that error wrapped in
+// ErrRequestRejected. Returned params must be valid JSON or empty.
+func WithRequestInterceptor(interceptor func(method string, params json.RawMessage) (json.RawMessage, error)) ClientOption {
+	return func(c *Client) {
+		c.requestInterceptor = interceptor
+	}
+}
+
+// WithNotificationHandlers registers notification handlers while the client
+// is being constructed, before the transport can deliver any notification.
+// Each entry behaves as if passed to OnNotification; nil handlers are
+// ignored. Repeated uses add to, and for the same method replace, earlier
+// entries.
+func WithNotificationHandlers(handlers map[string]NotificationHandler) ClientOption {
+	return func(c *Client) {
+		for method, handler := range handlers {
+			if handler != nil {
+				c.notificationListeners[method] = handler
+			}
+		}
+	}
+}
+
 // NewClient creates a new Client using the given transport and options.
 func NewClient(transport Transport, opts ...ClientOption) *Client {
 	if transport == nil {
@@ -297,6 +425,10 @@ func NewClient(transport Transport, opts ...ClientOption) *Client {
 	c.Plugin = newPluginService(c)
 	c.FuzzyFileSearch = newFuzzyFileSearchService(c)
 	c.installThreadStateCache()
+	c.installConfigWarningLog()
+	c.installThreadUsageTracker()
+	c.installRateLimitTracker()
+	c.installResolvedRequestTracker()
 
 	// Register the transport's notification handler to route to our listeners
 	transport.OnNotify(c.handleNotification)
@@ -307,6 +439,16 @@ func NewClient(transport Transport, opts ...ClientOption) *Client {
 	return c
 }
 
+// RequestTimeout returns the default request timeout configured with
+// WithRequestTimeout, or zero when requests have no default timeout. Send
+// applies it only to contexts without their own deadline.
+func (c *Client) RequestTimeout() time.Duration {
+	if c.requestTimeout <= 0 {
+		return 0
+	}
+	return c.requestTimeout
+}
+
 // Send transmits a JSON-RPC request and waits for the response.
 // Returns an RPCError if the response contains an error field.
 // Returns a TimeoutError if the context deadline is exceeded.
@@ -316,6 +458,18 @@ func (c *Client) Send(ctx context.Context, req Request) (Response, error) {
 		return Response{}, ErrNilContext
 	}
 
+	if c.requestInterceptor != nil {
+		params, err := c.interceptRequest(req.Method, req.Params)
+		if err != nil {
+			return Response{}, err
+		}
+		req.Params = params
+	}
+
+	if err := c.waitForRateLimitReset(ctx, req.Method); err != nil {
+		return Response{}, err
+	}
+
 	// Apply default timeout if context has no deadline and we have a default timeout
 	if c.requestTimeout > 0 {
 		if _, hasDeadline := ctx.Deadline(); !hasDeadline {
@@ -326,7 +480,9 @@ func (c *Client) Send(ctx context.Context, req Request) (Response, error) {
 	}
 
 	// Send the request
+	start := time.Now()
 	resp, err := c.transport.Send(ctx, req)
+	c.recordMethodLatency(req.Method, time.Since(start), err != nil || resp.Error != nil)
 	if err != nil {
 		// Only translate to context errors when the transport error was
 		// actually caused by context cancellation/deadline, not when the
@@ -353,6 +509,19 @@ func (c *Client) Send(ctx context.Context, req Request) (Response, error) {
 	return resp, nil
 }
 
+// interceptRequest runs the request interceptor on a copy of params so the
+// hook cannot mutate the caller's buffer.
+func (c *Client) interceptRequest(method string, params json.RawMessage) (json.RawMessage, error) {
+	intercepted, err := c.requestInterceptor(method, append(json.RawMessage(nil), params...))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %w", ErrRequestRejected, method, err)
+	}
+	if len(bytes.TrimSpace(intercepted)) > 0 && !json.Valid(intercepted) {
+		return nil, fmt.Errorf("%w: %s: interceptor returned invalid JSON params", ErrRequestRejected, method)
+	}
+	return intercepted, nil
+}
+
 // OnNotification registers a listener for incoming notifications with the given method.
 // When a notification with this method arrives from the server, the handler will be called.
 // Only one handler can be registered per method; subsequent calls replace the previous handler.
@@ -367,6 +536,18 @@ func (c *Client) OnNotification(method string, handler NotificationHandler) {
 	}
 }
 
+// OnUnknownNotification registers a handler for notifications whose method has
+// no typed payload in this SDK, typically because a newer server added it.
+// The handler receives the raw method and params and runs after any
+// OnNotification handler registered for the same method. Only one handler can
+// be registered; calling it again replaces the previous one, and nil restores
+// the default of silently ignoring unknown notifications.
+func (c *Client) OnUnknownNotification(handler func(method string, params json.RawMessage)) {
+	c.listenersMu.Lock()
+	defer c.listenersMu.Unlock()
+	c.unknownNotificationHandler = handler
+}
+
 // panicToError converts a recovered panic value to an error.
 func panicToError(v any) error {
 	switch e := v.(type) {
@@ -382,6 +563,9 @@ func panicToError(v any) error {
 // reportHandlerError invokes the handler error callback if set.
 // Recovers from callback panics to prevent double-fault crashes.
 func (c *Client) reportHandlerError(method string, err error) {
+	if err != nil {
+		c.diagnostics.handlerErrors.record(method, fmt.Sprintf("%T", err))
+	}
 	cb := c.handlerErrorCallback
 	if cb == nil {
 		return
@@ -396,11 +580,17 @@ func (c *Client) ReportHandlerError(method string, err error) {
 }
 
 // safeCallNotificationHandler calls fn, recovering any panic and reporting it
-// via reportHandlerError.
-func (c *Client) safeCallNotificationHandler(method string, fn func()) {
+// via reportHandlerError as a *HandlerError carrying the redacted params and
+// the stack.
+func (c *Client) safeCallNotificationHandler(notif Notification, fn func()) {
 	defer func() {
 		if r := recover(); r != nil {
-			c.reportHandlerError(method, panicToError(r))
+			c.reportHandlerError(notif.Method, &HandlerError{
+				Method:    notif.Method,
+				Params:    redactParams(notif.Params),
+				Recovered: r,
+				Stack:     debug.Stack(),
+			})
 		}
 	}()
 	fn()
@@ -413,6 +603,12 @@ func (c *Client) safeCallNotificationHandler(method string, fn func()) {
 // Each handler is called in isolation so a panic in one does not prevent others
 // from executing.
 func (c *Client) handleNotification(ctx context.Context, notif Notification) {
+	if c.notificationAllowlist != nil {
+		if _, ok := c.notificationAllowlist[notif.Method]; !ok {
+			return
+		}
+	}
+
 	c.listenersMu.RLock()
 	handler := c.notificationListeners[notif.Method]
 	// Deep-copy internal listeners so concurrent unsubscribe can't mutate the
@@ -420,25 +616,46 @@ func (c *Client) handleNotification(ctx context.Context, notif Notification) {
 	src := c.internalListeners[notif.Method]
 	internals := make([]internalListener, len(src))
 	copy(internals, src)
+	var unknown func(method string, params json.RawMessage)
+	_, known := notificationParamTypes[notif.Method]
+	if !known {
+		unknown = c.unknownNotificationHandler
+	}
 	c.listenersMu.RUnlock()
 
+	if !known {
+		c.diagnostics.unknownNotifications.record(notif.Method, "")
+	}
+
 	for _, il := range internals {
-		c.safeCallNotificationHandler(notif.Method, func() {
+		c.safeCallNotificationHandler(notif, func() {
 			il.handler(ctx, notif)
 		})
 	}
 
 	if handler != nil {
-		c.safeCallNotificationHandler(notif.Method, func() {
+		c.safeCallNotificationHandler(notif, func() {
 			handler(ctx, notif)
 		})
 	}
+
+	if unknown != nil {
+		c.safeCallNotificationHandler(notif, func() {
+			unknown(notif.Method, notif.Params)
+		})
+	}
 }
 
 // addNotificationListener appends an internal listener for the given method.
 // Returns an unsubscribe function that removes this specific listener.
 // Unlike OnNotification, multiple listeners can coexist for the same method.
 func (c *Client) addNotificationListener(method string, handler NotificationHandler) func() {
+	return c.appendNotificationListener(method, handler, false)
+}
+
+// appendNotificationListener implements addNotificationListener; registered
+// reports whether the listener came from the caller rather than the client.
+func (c *Client) appendNotificationListener(method string, handler NotificationHandler, registered bool) func() {
 	if handler == nil {
 		return func() {}
 	}
@@ -446,8 +663,9 @@ func (c *Client) addNotificationListener(method string, handler NotificationHand
 	c.internalListenerSeq++
 	id := c.internalListenerSeq
 	c.internalListeners[method] = append(c.internalListeners[method], internalListener{
-		id:      id,
-		handler: handler,
+		id:         id,
+		handler:    handler,
+		registered: registered,
 	})
 	c.listenersMu.Unlock()
 
@@ -467,7 +685,47 @@ func (c *Client) addNotificationListener(method string, handler NotificationHand
 // AddNotificationListener appends a notification listener for method and returns
 // an unsubscribe function for that specific listener.
 func (c *Client) AddNotificationListener(method string, handler NotificationHandler) func() {
-	return c.addNotificationListener(method, handler)
+	return c.appendNotificationListener(method, handler, true)
+}
+
+// OnMethod registers a low-level handler for notifications with the given
+// method, receiving the raw params. It works for any method, including ones
+// this SDK has no typed support for, and coexists with OnNotification and the
+// typed On* methods rather than replacing them. Handlers run with the same
+// panic recovery as every other listener. It returns a function that removes
+// this handler.
+func (c *Client) OnMethod(method string, handler func(ctx context.Context, params json.RawMessage)) func() {
+	if handler == nil {
+		return func() {}
+	}
+	return c.appendNotificationListener(method, func(ctx context.Context, notif Notification) {
+		handler(ctx, notif.Params)
+	}, true)
+}
+
+// RegisteredNotifications returns the sorted notification methods that
+// currently have a caller-registered listener: one set through OnNotification
+// or a typed On* method, or added through AddNotificationListener or OnMethod.
+// Listeners the client installs for its own bookkeeping are not reported, nor
+// is the OnUnknownNotification handler, which is not bound to a method.
+func (c *Client) RegisteredNotifications() []string {
+	c.listenersMu.RLock()
+	defer c.listenersMu.RUnlock()
+
+	methods := make([]string, 0, len(c.notificationListeners))
+	for method := range c.notificationListeners {
+		methods = append(methods, method)
+	}
+	for method, listeners := range c.internalListeners {
+		if _, ok := c.notificationListeners[method]; ok {
+			continue
+		}
+		if slices.ContainsFunc(listeners, func(l internalListener) bool { return l.registered }) {
+			methods = append(methods, method)
+		}
+	}
+	slices.Sort(methods)
+	return methods
 }
 
 // handleRequest is the internal handler for server→client requests (approval flows).
@@ -475,6 +733,7 @@ func (c *Client) AddNotificationListener(method string, handler NotificationHand
 // Panics in approval handlers are recovered and reported via the handler error
 // callback. Errors returned by approval handlers are also reported.
 func (c *Client) handleRequest(ctx context.Context, req Request) (resp Response, err error) {
+	ctx = withApprovalMetadata(ctx, req, time.Now())
 	defer func() {
 		if r := recover(); r != nil {
 			pErr := panicToError(r)
@@ -496,7 +755,7 @@ func (c *Client) handleRequest(ctx context.Context, req Request) (resp Response,
 func (c *Client) dispatchApproval(ctx context.Context, req Request) (Response, error) {
 	// Snapshot handlers under read lock, then release before calling
 	c.approvalMu.RLock()
-	handlers := c.approvalHandlers
+	handlers := c.approvalHandlersFor(req.Params)
 	c.approvalMu.RUnlock()
 
 	// Route based on method to the appropriate approval handler.
@@ -522,6 +781,9 @@ func (c *Client) dispatchApproval(ctx context.Context, req Request) (Response, e
 		return handleApproval(ctx, req, handlers.OnExecCommandApproval)
 
 	case methodFileChangeRequestApproval:
+		if c.fileApprovalWindow > 0 && handlers.OnBatchFileChangeApproval != nil {
+			return c.coalesceFileChangeApproval(ctx, req, handlers.OnBatchFileChangeApproval)
+		}
 		if handlers.OnFileChangeRequestApproval == nil {
 			return methodNotFoundResponse(req.ID), nil
 		}
@@ -594,6 +856,12 @@ func handleApproval[P any, R any](ctx context.Context, req Request, handler func
 	if err != nil {
 		return Response{}, fmt.Errorf("approval handler %s failed: %w", req.Method, err)
 	}
+	return approvalResultResponse(req, result)
+}
+
+// approvalResultResponse validates an approval handler's result and encodes
+// it as the JSON-RPC response to req.
+func approvalResultResponse[R any](req Request, result R) (Response, error) {
 	if err := validateDecodedResponse(result); err != nil {
 		return Response{}, fmt.Errorf("validate %s result: %w", req.Method, err)
 	}
@@ -612,12 +880,19 @@ func handleApproval[P any, R any](ctx context.Context, req Request, handler func
 
 // Close closes the underlying transport and releases resources.
 func (c *Client) Close() error {
+	c.closeFileApprovalBatches()
 	return c.transport.Close()
 }
 
-// nextRequestID generates a unique request ID for outgoing requests.
-func (c *Client) nextRequestID() uint64 {
-	return c.requestIDCounter.Add(1)
+// nextRequestID generates a unique request ID for outgoing requests. IDs are
+// numeric unless a prefix was configured with WithIDPrefix, in which case they
+// are strings of the form "<prefix>:<n>".
+func (c *Client) nextRequestID() RequestID {
+	n := c.requestIDCounter.Add(1)
+	if c.requestIDPrefix == "" {
+		return RequestID{Value: n}
+	}
+	return RequestID{Value: c.requestIDPrefix + ":" + strconv.FormatUint(n, 10)}
 }
 
 // sendResponse is a helper that sends a typed request and returns the raw response.
@@ -628,6 +903,11 @@ func (c *Client) sendResponse(ctx context.Context, method string, params interfa
 		if err != nil {
 			return Response{}, fmt.Errorf("%s: %w", method, err)
 		}
+		if c.pathValidation {
+			if err := validateSandboxRootsExist(preparedParams); err != nil {
+				return Response{}, fmt.Errorf("%s: %w", method, err)
+			}
+		}
 
 		paramsJSON, err = marshalForWire(preparedParams)
 		if err != nil {
@@ -640,7 +920,7 @@ func (c *Client) sendResponse(ctx context.Context, method string, params interfa
 		JSONRPC: jsonrpcVersion,
 		Method:  method,
 		Params:  paramsJSON,
-		ID:      RequestID{Value: c.nextRequestID()},
+		ID:      c.nextRequestID(),
 	}
 
 	// Send request
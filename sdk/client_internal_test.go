@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"testing"
+	"time"
 )
 
 func TestMarshalForWireFallback(t *testing.T) {
@@ -176,3 +177,29 @@ func TestSendRequestRejectsNilResultTarget(t *testing.T) {
 		t.Fatalf("sendRequest() error = %v, want errNilResponseTarget", err)
 	}
 }
+
+func TestMethodStatsPercentilesUseRecentSamples(t *testing.T) {
+	c := &Client{}
+	for i := 1; i <= 100; i++ {
+		c.recordMethodLatency("thread/list", time.Duration(i)*time.Millisecond, i%10 == 0)
+	}
+
+	stat := c.MethodStats()["thread/list"]
+	if stat.Count != 100 || stat.Errors != 10 {
+		t.Fatalf("count/errors = %d/%d; want 100/10", stat.Count, stat.Errors)
+	}
+	if stat.P50 != 50*time.Millisecond || stat.P95 != 95*time.Millisecond || stat.Max != 100*time.Millisecond {
+		t.Fatalf("p50/p95/max = %v/%v/%v; want 50ms/95ms/100ms", stat.P50, stat.P95, stat.Max)
+	}
+
+	for range methodStatSamples {
+		c.recordMethodLatency("thread/list", time.Millisecond, false)
+	}
+	stat = c.MethodStats()["thread/list"]
+	if stat.P95 != time.Millisecond {
+		t.Fatalf("p95 after fast requests = %v; want old samples evicted", stat.P95)
+	}
+	if stat.Max != 100*time.Millisecond {
+		t.Fatalf("max = %v; want all-time max retained", stat.Max)
+	}
+}
@@ -64,6 +64,56 @@ func TestClientSendRequest(t *testing.T) {
 	}
 }
 
+// TestClientWithIDPrefixNamespacesRequestIDs verifies that WithIDPrefix
+// produces string IDs that survive the wire format and never match another
+// client's IDs or a bare numeric ID.
+func TestClientWithIDPrefixNamespacesRequestIDs(t *testing.T) {
+	ctx := context.Background()
+	mock := NewMockTransport()
+	clientA := codex.NewClient(mock, codex.WithIDPrefix("clientA"))
+	clientB := codex.NewClient(mock, codex.WithIDPrefix("clientB"))
+	_ = mock.SetResponseData("account/logout", map[string]interface{}{})
+
+	if _, err := clientA.Account.Logout(ctx); err != nil {
+		t.Fatalf("clientA Logout: %v", err)
+	}
+	if _, err := clientB.Account.Logout(ctx); err != nil {
+		t.Fatalf("clientB Logout: %v", err)
+	}
+
+	idA := mock.GetSentRequest(0).ID
+	idB := mock.GetSentRequest(1).ID
+	if idA.Value != "clientA:1" || idB.Value != "clientB:1" {
+		t.Fatalf("request IDs = %v, %v; want clientA:1, clientB:1", idA.Value, idB.Value)
+	}
+	if idA.Equal(idB) {
+		t.Fatal("IDs from different prefixes must not match")
+	}
+
+	data, err := json.Marshal(codex.Response{JSONRPC: "2.0", ID: idA, Result: json.RawMessage(`{}`)})
+	if err != nil {
+		t.Fatalf("marshal response: %v", err)
+	}
+	var decoded codex.Response
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("unmarshal response: %v", err)
+	}
+	if !decoded.ID.Equal(idA) {
+		t.Fatalf("decoded ID %v does not match sent ID %v", decoded.ID.Value, idA.Value)
+	}
+	if decoded.ID.Equal(codex.RequestID{Value: int64(1)}) {
+		t.Fatal("prefixed ID must not match a numeric ID")
+	}
+
+	unprefixed := codex.NewClient(mock)
+	if _, err := unprefixed.Account.Logout(ctx); err != nil {
+		t.Fatalf("unprefixed Logout: %v", err)
+	}
+	if _, ok := mock.GetSentRequest(2).ID.Value.(string); ok {
+		t.Fatalf("default request ID = %v; want numeric", mock.GetSentRequest(2).ID.Value)
+	}
+}
+
 func TestNoParamServiceRequestsOmitParams(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -187,6 +237,229 @@ func TestClientNotificationListener(t *testing.T) {
 	}
 }
 
+// TestClientNotificationAllowlistDropsOtherMethods verifies that only
+// allowlisted notification methods reach listeners and client bookkeeping.
+func TestClientNotificationAllowlistDropsOtherMethods(t *testing.T) {
+	ctx := context.Background()
+	mock := NewMockTransport()
+	client := codex.NewClient(mock, codex.WithNotificationAllowlist("thread/tokenUsage/updated"))
+
+	var deltas int
+	client.OnAgentMessageDelta(func(codex.AgentMessageDeltaNotification) {
+		deltas++
+	})
+	var unknown int
+	client.OnUnknownNotification(func(string, json.RawMessage) {
+		unknown++
+	})
+
+	mock.InjectServerNotification(ctx, codex.Notification{
+		JSONRPC: "2.0",
+		Method:  "item/agentMessage/delta",
+		Params:  json.RawMessage(`{"delta":"x","itemId":"i","threadId":"t","turnId":"u"}`),
+	})
+	mock.InjectServerNotification(ctx, codex.Notification{
+		JSONRPC: "2.0",
+		Method:  "configWarning",
+		Params:  json.RawMessage(`{"summary":"ignored"}`),
+	})
+	mock.InjectServerNotification(ctx, codex.Notification{
+		JSONRPC: "2.0",
+		Method:  "future/feature",
+		Params:  json.RawMessage(`{}`),
+	})
+	mock.InjectServerNotification(ctx, codex.Notification{
+		JSONRPC: "2.0",
+		Method:  "thread/tokenUsage/updated",
+		Params: json.RawMessage(`{"threadId":"t","turnId":"u","tokenUsage":{
+			"last":{"cachedInputTokens":0,"inputTokens":1,"outputTokens":1,"reasoningOutputTokens":0,"totalTokens":2},
+			"total":{"cachedInputTokens":0,"inputTokens":1,"outputTokens":1,"reasoningOutputTokens":0,"totalTokens":2}}}`),
+	})
+
+	if deltas != 0 || unknown != 0 {
+		t.Fatalf("filtered notifications dispatched: deltas=%d unknown=%d", deltas, unknown)
+	}
+	if got := client.ConfigWarnings(); len(got) != 0 {
+		t.Fatalf("ConfigWarnings() = %v; want filtered warning dropped", got)
+	}
+	if usage, ok := client.ThreadUsage("t"); !ok || usage.Total.TotalTokens != 2 {
+		t.Fatalf("ThreadUsage(t) = %+v, %v; want allowlisted usage recorded", usage, ok)
+	}
+}
+
+// TestClientRequestInterceptorRewritesAndRejects verifies that the request
+// interceptor sees wire params, can rewrite them, and can abort a request
+// before it reaches the transport.
+func TestClientRequestInterceptorRewritesAndRejects(t *testing.T) {
+	ctx := context.Background()
+	mock := NewMockTransport()
+	errPolicy := errors.New("method not allowed")
+	client := codex.NewClient(mock, codex.WithRequestInterceptor(func(method string, params json.RawMessage) (json.RawMessage, error) {
+		if method == "thread/archive" {
+			return nil, errPolicy
+		}
+		var fields map[string]any
+		if err := json.Unmarshal(params, &fields); err != nil {
+			return nil, err
+		}
+		fields["sandbox"] = codex.SandboxModeReadOnly
+		return json.Marshal(fields)
+	}))
+	_ = mock.SetResponseData("test.method", map[string]interface{}{})
+
+	original := json.RawMessage(`{"model":"m","sandbox":"danger-full-access"}`)
+	_, err := client.Send(ctx, codex.Request{
+		JSONRPC: "2.0",
+		ID:      codex.RequestID{Value: uint64(1)},
+		Method:  "test.method",
+		Params:  original,
+	})
+	if err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	if got := string(mock.GetSentRequest(0).Params); got != `{"model":"m","sandbox":"read-only"}` {
+		t.Fatalf("sent params = %s; want sandbox forced to read-only", got)
+	}
+	if string(original) != `{"model":"m","sandbox":"danger-full-access"}` {
+		t.Fatalf("caller params were mutated: %s", original)
+	}
+
+	_, err = client.Send(ctx, codex.Request{
+		JSONRPC: "2.0",
+		ID:      codex.RequestID{Value: uint64(2)},
+		Method:  "thread/archive",
+		Params:  json.RawMessage(`{"threadId":"t"}`),
+	})
+	if !errors.Is(err, codex.ErrRequestRejected) || !errors.Is(err, errPolicy) {
+		t.Fatalf("Send() error = %v; want ErrRequestRejected wrapping the policy error", err)
+	}
+	if got := mock.CallCount(); got != 1 {
+		t.Fatalf("transport calls = %d; want rejected request not sent", got)
+	}
+}
+
+// eagerTransport delivers a queued notification and server request the moment
+// the client registers its handlers, as a live transport might before NewClient
+// returns to the caller.
+type eagerTransport struct {
+	*MockTransport
+	notif  codex.Notification
+	req    codex.Request
+	resp   codex.Response
+	reqErr error
+}
+
+func (t *eagerTransport) OnNotify(handler codex.NotificationHandler) {
+	t.MockTransport.OnNotify(handler)
+	handler(context.Background(), t.notif)
+}
+
+func (t *eagerTransport) OnRequest(handler codex.RequestHandler) {
+	t.MockTransport.OnRequest(handler)
+	t.resp, t.reqErr = handler(context.Background(), t.req)
+}
+
+// TestClientInitialHandlersReceiveEarliestMessages verifies that handlers
+// installed through WithNotificationHandlers and WithApprovalHandlers are in
+// place before the transport can dispatch anything.
+func TestClientInitialHandlersReceiveEarliestMessages(t *testing.T) {
+	transport := &eagerTransport{
+		MockTransport: NewMockTransport(),
+		notif: codex.Notification{
+			JSONRPC: "2.0",
+			Method:  "configWarning",
+			Params:  json.RawMessage(`{"summary":"early"}`),
+		},
+		req: codex.Request{
+			JSONRPC: "2.0",
+			ID:      codex.RequestID{Value: uint64(1)},
+			Method:  "item/fileChange/requestApproval",
+			Params:  json.RawMessage(`{"itemId":"i","startedAtMs":1,"threadId":"t","turnId":"u"}`),
+		},
+	}
+
+	var notified []string
+	codex.NewClient(transport,
+		codex.WithNotificationHandlers(map[string]codex.NotificationHandler{
+			"configWarning": func(_ context.Context, notif codex.Notification) {
+				notified = append(notified, notif.Method)
+			},
+			"turn/completed": nil,
+		}),
+		codex.WithApprovalHandlers(codex.ApprovalHandlers{
+			OnFileChangeRequestApproval: func(context.Context, codex.FileChangeRequestApprovalParams) (codex.FileChangeRequestApprovalResponse, error) {
+				return codex.FileChangeRequestApprovalResponse{Decision: codex.FileChangeApprovalDecisionAccept}, nil
+			},
+		}),
+	)
+
+	if len(notified) != 1 {
+		t.Fatalf("early notification dispatched %d times; want 1", len(notified))
+	}
+	if transport.reqErr != nil {
+		t.Fatalf("early approval request error = %v", transport.reqErr)
+	}
+	if got := string(transport.resp.Result); got != `{"decision":"accept"}` {
+		t.Fatalf("early approval result = %s; want accept", got)
+	}
+}
+
+// TestClientRequestTimeoutReportsConfiguredValue verifies the RequestTimeout
+// accessor for configured, unset, and non-positive timeouts.
+func TestClientRequestTimeoutReportsConfiguredValue(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []codex.ClientOption
+		want time.Duration
+	}{
+		{name: "configured", opts: []codex.ClientOption{codex.WithRequestTimeout(3 * time.Second)}, want: 3 * time.Second},
+		{name: "unset", want: 0},
+		{name: "negative", opts: []codex.ClientOption{codex.WithRequestTimeout(-time.Second)}, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := codex.NewClient(NewMockTransport(), tt.opts...)
+			if got := client.RequestTimeout(); got != tt.want {
+				t.Fatalf("RequestTimeout() = %v; want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestClientMethodStatsCountsRequestsPerMethod verifies that Send records
+// per-method latency statistics, including failed requests.
+func TestClientMethodStatsCountsRequestsPerMethod(t *testing.T) {
+	ctx := context.Background()
+	mock := NewMockTransport()
+	client := codex.NewClient(mock)
+	_ = mock.SetResponseData("account/logout", map[string]interface{}{})
+	mock.SetResponse("config/read", codex.Response{
+		JSONRPC: "2.0",
+		Error:   &codex.Error{Code: -32603, Message: "boom"},
+	})
+
+	for range 3 {
+		if _, err := client.Account.Logout(ctx); err != nil {
+			t.Fatalf("Logout: %v", err)
+		}
+	}
+	if _, err := client.Config.Read(ctx, codex.ConfigReadParams{}); err == nil {
+		t.Fatal("expected config/read error")
+	}
+
+	stats := client.MethodStats()
+	if got := stats["account/logout"]; got.Count != 3 || got.Errors != 0 {
+		t.Fatalf("account/logout stats = %+v; want 3 requests, 0 errors", got)
+	}
+	if got := stats["config/read"]; got.Count != 1 || got.Errors != 1 {
+		t.Fatalf("config/read stats = %+v; want 1 request, 1 error", got)
+	}
+	if got := stats["account/logout"]; got.Max < got.P95 || got.P95 < got.P50 {
+		t.Fatalf("account/logout percentiles out of order: %+v", got)
+	}
+}
+
 // TestClientUnknownNotification verifies that unknown notification methods don't cause errors.
 func TestClientUnknownNotification(t *testing.T) {
 	mock := NewMockTransport()
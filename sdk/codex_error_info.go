@@ -0,0 +1,135 @@
+package codex
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// CodexErrorInfo is the structured form of a turn error's codexErrorInfo.
+//
+// On the wire it is either a bare code string (for example
+// "contextWindowExceeded") or a single-key object naming the code, whose value
+// carries variant details. HTTPStatusCode is set only for the HTTP and
+// response-stream failure codes, when the server forwarded an upstream status.
+// TurnKind is set only for CodexErrorCodeActiveTurnNotSteerable.
+//
+// A code from a newer protocol version is kept as is: Code.Valid() reports
+// false and Raw holds the original JSON, which MarshalJSON re-emits.
+type CodexErrorInfo struct {
+	Code           CodexErrorCode
+	HTTPStatusCode *uint16
+	TurnKind       *NonSteerableTurnKind
+	Raw            json.RawMessage
+}
+
+// codexErrorInfoDetailCodes are the codes encoded as single-key objects.
+var codexErrorInfoDetailCodes = newEnumSet(
+	CodexErrorCodeHTTPConnectionFailed,
+	CodexErrorCodeResponseStreamConnectionFailed,
+	CodexErrorCodeResponseStreamDisconnected,
+	CodexErrorCodeResponseTooManyFailedAttempts,
+	CodexErrorCodeActiveTurnNotSteerable,
+)
+
+type codexErrorHTTPDetails struct {
+	HTTPStatusCode *uint16 `json:"httpStatusCode,omitempty"`
+}
+
+type codexErrorSteerDetails struct {
+	TurnKind NonSteerableTurnKind `json:"turnKind"`
+}
+
+func (i *CodexErrorInfo) UnmarshalJSON(data []byte) error {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) > 0 && trimmed[0] == '"' {
+		var code CodexErrorCode
+		if err := json.Unmarshal(trimmed, &code); err != nil {
+			return err
+		}
+		if !code.Valid() {
+			*i = CodexErrorInfo{Code: code, Raw: append(json.RawMessage(nil), trimmed...)}
+			return nil
+		}
+		if _, ok := codexErrorInfoDetailCodes[code]; ok {
+			return fmt.Errorf("codexErrorInfo %q must be an object", code)
+		}
+		*i = CodexErrorInfo{Code: code}
+		return nil
+	}
+
+	var variants map[CodexErrorCode]json.RawMessage
+	if err := json.Unmarshal(trimmed, &variants); err != nil {
+		return err
+	}
+	if variants == nil {
+		return errors.New("codexErrorInfo must be a string or object")
+	}
+	if len(variants) != 1 {
+		return fmt.Errorf("codexErrorInfo object must have exactly one key, got %d", len(variants))
+	}
+
+	for code, details := range variants {
+		if !code.Valid() {
+			*i = CodexErrorInfo{Code: code, Raw: append(json.RawMessage(nil), trimmed...)}
+			return nil
+		}
+		if _, ok := codexErrorInfoDetailCodes[code]; !ok {
+			return fmt.Errorf("codexErrorInfo %q must be a string", code)
+		}
+		decoded := CodexErrorInfo{Code: code}
+		if code == CodexErrorCodeActiveTurnNotSteerable {
+			var steer codexErrorSteerDetails
+			required := []string{"turnKind"}
+			if err := unmarshalInboundObject(details, &steer, required, required); err != nil {
+				return fmt.Errorf("codexErrorInfo %s: %w", code, err)
+			}
+			decoded.TurnKind = &steer.TurnKind
+		} else {
+			var http codexErrorHTTPDetails
+			if err := unmarshalInboundObject(details, &http, nil, nil); err != nil {
+				return fmt.Errorf("codexErrorInfo %s: %w", code, err)
+			}
+			decoded.HTTPStatusCode = http.HTTPStatusCode
+		}
+		*i = decoded
+	}
+	return nil
+}
+
+func (i CodexErrorInfo) MarshalJSON() ([]byte, error) {
+	if !i.Code.Valid() && i.Raw != nil {
+		return i.Raw, nil
+	}
+	if err := validateEnumValue("codexErrorInfo", i.Code, validCodexErrorCodes); err != nil {
+		return nil, err
+	}
+	if _, ok := codexErrorInfoDetailCodes[i.Code]; !ok {
+		return json.Marshal(string(i.Code))
+	}
+	if i.Code == CodexErrorCodeActiveTurnNotSteerable {
+		if i.TurnKind == nil {
+			return nil, fmt.Errorf("codexErrorInfo %s requires turnKind", i.Code)
+		}
+		return json.Marshal(map[CodexErrorCode]codexErrorSteerDetails{i.Code: {TurnKind: *i.TurnKind}})
+	}
+	return json.Marshal(map[CodexErrorCode]codexErrorHTTPDetails{i.Code: {HTTPStatusCode: i.HTTPStatusCode}})
+}
+
+// Info decodes CodexErrorInfo into its structured form. It returns nil and no
+// error when the server did not send codexErrorInfo.
+func (e *TurnError) Info() (*CodexErrorInfo, error) {
+	if e == nil {
+		return nil, nil
+	}
+	trimmed := bytes.TrimSpace(e.CodexErrorInfo)
+	if len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null")) {
+		return nil, nil
+	}
+	var info CodexErrorInfo
+	if err := json.Unmarshal(trimmed, &info); err != nil {
+		return nil, fmt.Errorf("decode codexErrorInfo: %w", err)
+	}
+	return &info, nil
+}
@@ -0,0 +1,127 @@
+package codex_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	codex "github.com/dominicnunez/codex-sdk-go/sdk"
+)
+
+func TestTurnErrorInfo(t *testing.T) {
+	status := uint16(503)
+	review := codex.NonSteerableTurnKindReview
+
+	tests := []struct {
+		name string
+		raw  string
+		want *codex.CodexErrorInfo
+	}{
+		{name: "absent", raw: ``, want: nil},
+		{name: "null", raw: `null`, want: nil},
+		{
+			name: "plain code",
+			raw:  `"contextWindowExceeded"`,
+			want: &codex.CodexErrorInfo{Code: codex.CodexErrorCodeContextWindowExceeded},
+		},
+		{
+			name: "http status",
+			raw:  `{"httpConnectionFailed":{"httpStatusCode":503}}`,
+			want: &codex.CodexErrorInfo{Code: codex.CodexErrorCodeHTTPConnectionFailed, HTTPStatusCode: &status},
+		},
+		{
+			name: "null http status",
+			raw:  `{"responseStreamDisconnected":{"httpStatusCode":null}}`,
+			want: &codex.CodexErrorInfo{Code: codex.CodexErrorCodeResponseStreamDisconnected},
+		},
+		{
+			name: "active turn not steerable",
+			raw:  `{"activeTurnNotSteerable":{"turnKind":"review"}}`,
+			want: &codex.CodexErrorInfo{Code: codex.CodexErrorCodeActiveTurnNotSteerable, TurnKind: &review},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			turnErr := &codex.TurnError{Message: "failed", CodexErrorInfo: json.RawMessage(tt.raw)}
+			got, err := turnErr.Info()
+			if err != nil {
+				t.Fatalf("Info() error = %v", err)
+			}
+			if tt.want == nil {
+				if got != nil {
+					t.Fatalf("Info() = %+v; want nil", got)
+				}
+				return
+			}
+			if got == nil {
+				t.Fatal("Info() = nil; want structured info")
+			}
+			if got.Code != tt.want.Code {
+				t.Fatalf("Code = %q; want %q", got.Code, tt.want.Code)
+			}
+			if (got.HTTPStatusCode == nil) != (tt.want.HTTPStatusCode == nil) ||
+				(got.HTTPStatusCode != nil && *got.HTTPStatusCode != *tt.want.HTTPStatusCode) {
+				t.Fatalf("HTTPStatusCode = %v; want %v", got.HTTPStatusCode, tt.want.HTTPStatusCode)
+			}
+			if (got.TurnKind == nil) != (tt.want.TurnKind == nil) ||
+				(got.TurnKind != nil && *got.TurnKind != *tt.want.TurnKind) {
+				t.Fatalf("TurnKind = %v; want %v", got.TurnKind, tt.want.TurnKind)
+			}
+
+			encoded, err := json.Marshal(got)
+			if err != nil {
+				t.Fatalf("json.Marshal(info) error = %v", err)
+			}
+			if tt.name != "null http status" && string(encoded) != tt.raw {
+				t.Fatalf("json.Marshal(info) = %s; want %s", encoded, tt.raw)
+			}
+		})
+	}
+}
+
+func TestTurnErrorInfoKeepsUnknownCodes(t *testing.T) {
+	for _, raw := range []string{`"quotaMelted"`, `{"quotaMelted":{"retryAfterSeconds":30}}`} {
+		turnErr := &codex.TurnError{Message: "failed", CodexErrorInfo: json.RawMessage(raw)}
+		info, err := turnErr.Info()
+		if err != nil {
+			t.Fatalf("Info(%s) error = %v", raw, err)
+		}
+		if info.Code != "quotaMelted" || info.Code.Valid() {
+			t.Fatalf("Info(%s).Code = %q; want the unknown code quotaMelted", raw, info.Code)
+		}
+		encoded, err := json.Marshal(info)
+		if err != nil {
+			t.Fatalf("json.Marshal(info) error = %v", err)
+		}
+		if string(encoded) != raw {
+			t.Fatalf("json.Marshal(info) = %s; want %s", encoded, raw)
+		}
+	}
+}
+
+func TestTurnErrorInfoRejectsMalformedPayloads(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want string
+	}{
+		{name: "detail code as string", raw: `"httpConnectionFailed"`, want: "must be an object"},
+		{name: "plain code as object", raw: `{"badRequest":{}}`, want: "must be a string"},
+		{name: "unknown code with multiple keys", raw: `{"quotaMelted":{},"other":{}}`, want: "exactly one key"},
+		{name: "multiple keys", raw: `{"httpConnectionFailed":{},"responseStreamDisconnected":{}}`, want: "exactly one key"},
+		{name: "missing turn kind", raw: `{"activeTurnNotSteerable":{}}`, want: "missing required field"},
+		{name: "invalid turn kind", raw: `{"activeTurnNotSteerable":{"turnKind":"plan"}}`, want: "invalid turnKind"},
+		{name: "wrong type", raw: `42`, want: "decode codexErrorInfo"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			turnErr := &codex.TurnError{Message: "failed", CodexErrorInfo: json.RawMessage(tt.raw)}
+			_, err := turnErr.Info()
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("Info() error = %v; want substring %q", err, tt.want)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 )
 
 // CommandExecTerminalSize represents a PTY size in character cells.
@@ -84,6 +85,24 @@ const (
 	CommandExecOutputStreamStderr CommandExecOutputStream = "stderr"
 )
 
+var allCommandExecOutputStreams = []CommandExecOutputStream{
+	CommandExecOutputStreamStdout,
+	CommandExecOutputStreamStderr,
+}
+
+var validCommandExecOutputStreams = newEnumSet(allCommandExecOutputStreams...)
+
+// CommandExecOutputStreamValues returns every known CommandExecOutputStream value in protocol order.
+func CommandExecOutputStreamValues() []CommandExecOutputStream {
+	return slices.Clone(allCommandExecOutputStreams)
+}
+
+// Valid reports whether c is a known CommandExecOutputStream value.
+func (c CommandExecOutputStream) Valid() bool {
+	_, ok := validCommandExecOutputStreams[c]
+	return ok
+}
+
 // CommandExecOutputDeltaNotification represents streamed output for standalone command/exec calls.
 type CommandExecOutputDeltaNotification struct {
 	CapReached  bool                    `json:"capReached"`
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"strings"
 	"testing"
 
@@ -743,3 +744,40 @@ func TestConfigWarningMissingSummaryReportsHandlerError(t *testing.T) {
 		t.Fatalf("handler error = %v; want missing required field failure", gotErr)
 	}
 }
+
+func TestClientConfigWarningsAccumulateAndClear(t *testing.T) {
+	mock := NewMockTransport()
+	client := codex.NewClient(mock)
+	ctx := context.Background()
+
+	if got := client.ConfigWarnings(); len(got) != 0 {
+		t.Fatalf("ConfigWarnings() = %v; want empty before any notification", got)
+	}
+
+	const total = 105
+	for i := range total {
+		mock.InjectServerNotification(ctx, codex.Notification{
+			JSONRPC: "2.0",
+			Method:  "configWarning",
+			Params:  json.RawMessage(fmt.Sprintf(`{"summary":"warning %d"}`, i)),
+		})
+	}
+
+	warnings := client.ConfigWarnings()
+	if len(warnings) != 100 {
+		t.Fatalf("len(ConfigWarnings()) = %d; want cap of 100", len(warnings))
+	}
+	if warnings[0].Summary != "warning 5" || warnings[99].Summary != "warning 104" {
+		t.Fatalf("retained warnings span %q..%q; want the most recent 100", warnings[0].Summary, warnings[99].Summary)
+	}
+
+	warnings[0].Summary = "mutated"
+	if got := client.ConfigWarnings()[0].Summary; got != "warning 5" {
+		t.Fatalf("ConfigWarnings() exposed internal state; got %q after caller mutation", got)
+	}
+
+	client.ClearConfigWarnings()
+	if got := client.ConfigWarnings(); len(got) != 0 {
+		t.Fatalf("ConfigWarnings() after clear = %v; want empty", got)
+	}
+}
@@ -0,0 +1,47 @@
+package codex
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// maxConfigWarnings bounds how many configWarning notifications the client
+// retains. Once the cap is reached the oldest warnings are dropped.
+const maxConfigWarnings = 100
+
+// ConfigWarnings returns the configWarning notifications received so far, in
+// arrival order. At most the 100 most recent warnings are retained. The
+// returned slice is a copy and is safe to modify.
+func (c *Client) ConfigWarnings() []ConfigWarningNotification {
+	c.configWarningsMu.Lock()
+	defer c.configWarningsMu.Unlock()
+	return cloneArbitraryValue(c.configWarnings)
+}
+
+// ClearConfigWarnings discards all accumulated configWarning notifications.
+func (c *Client) ClearConfigWarnings() {
+	c.configWarningsMu.Lock()
+	defer c.configWarningsMu.Unlock()
+	c.configWarnings = nil
+}
+
+func (c *Client) recordConfigWarning(warning ConfigWarningNotification) {
+	c.configWarningsMu.Lock()
+	defer c.configWarningsMu.Unlock()
+	if len(c.configWarnings) >= maxConfigWarnings {
+		c.configWarnings = append(c.configWarnings[:0], c.configWarnings[len(c.configWarnings)-maxConfigWarnings+1:]...)
+	}
+	c.configWarnings = append(c.configWarnings, warning)
+}
+
+func (c *Client) installConfigWarningLog() {
+	c.addNotificationListener(notifyConfigWarning, func(_ context.Context, notif Notification) {
+		var n ConfigWarningNotification
+		if err := json.Unmarshal(notif.Params, &n); err != nil {
+			c.reportHandlerError(notifyConfigWarning, fmt.Errorf("unmarshal %s: %w", notifyConfigWarning, err))
+			return
+		}
+		c.recordConfigWarning(n)
+	})
+}
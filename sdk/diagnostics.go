@@ -0,0 +1,158 @@
+package codex
+
+import (
+	"encoding/json"
+	"io"
+	"runtime"
+	"runtime/debug"
+	"sync"
+	"time"
+)
+
+// maxDiagnosticEvents bounds each recent-event log kept for DiagnosticsDump.
+// The oldest event is dropped first.
+const maxDiagnosticEvents = 32
+
+const sdkModulePath = "github.com/dominicnunez/codex-sdk-go"
+
+// DiagnosticsReport is a point-in-time summary of a client's state for support
+// bundles. It holds no request or notification params, credentials, or
+// filesystem paths, so it is safe to attach to a bug report.
+type DiagnosticsReport struct {
+	GeneratedAt time.Time `json:"generatedAt"`
+	GoVersion   string    `json:"goVersion"`
+	// SDKVersion is the module version of this SDK from the binary's build
+	// info, or empty when it is unavailable (for example in tests).
+	SDKVersion string `json:"sdkVersion,omitempty"`
+
+	// Initialized reports whether the initialize handshake has completed.
+	// Client and Server are set only when it has; Server omits CodexHome.
+	Initialized bool                    `json:"initialized"`
+	Client      *ClientInfo             `json:"client,omitempty"`
+	Server      *DiagnosticsServerInfo  `json:"server,omitempty"`
+	MethodStats map[string]MethodStat   `json:"methodStats"`
+	Listeners   []string                `json:"listeners"`
+	Threads     DiagnosticsThreadCounts `json:"threads"`
+
+	ConfigWarnings             int               `json:"configWarnings"`
+	RecentHandlerErrors        []DiagnosticEvent `json:"recentHandlerErrors"`
+	RecentUnknownNotifications []DiagnosticEvent `json:"recentUnknownNotifications"`
+}
+
+// DiagnosticsServerInfo is the part of the initialize response included in a
+// DiagnosticsReport.
+type DiagnosticsServerInfo struct {
+	UserAgent      string `json:"userAgent"`
+	PlatformFamily string `json:"platformFamily"`
+	PlatformOS     string `json:"platformOs"`
+}
+
+// DiagnosticsThreadCounts counts the threads the client currently tracks.
+type DiagnosticsThreadCounts struct {
+	// Snapshots is how many thread snapshots the client has cached.
+	Snapshots int `json:"snapshots"`
+	// Usage is how many threads have token usage recorded (see ThreadUsage).
+	Usage int `json:"usage"`
+}
+
+// DiagnosticEvent is one entry in a DiagnosticsReport's recent-event logs.
+// ErrorType is the Go type of a handler error, such as "*codex.HandlerError",
+// and is empty for unknown notifications. Error messages are not recorded
+// because they can quote params, paths, or panic values.
+type DiagnosticEvent struct {
+	Time      time.Time `json:"time"`
+	Method    string    `json:"method"`
+	ErrorType string    `json:"errorType,omitempty"`
+}
+
+// WriteTo writes the report to w as indented JSON followed by a newline.
+func (r DiagnosticsReport) WriteTo(w io.Writer) (int64, error) {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return 0, err
+	}
+	n, err := w.Write(append(data, '\n'))
+	return int64(n), err
+}
+
+// DiagnosticsDump collects a DiagnosticsReport: the SDK and Go versions, the
+// initialize handshake, per-method request latency, registered listeners,
+// tracked thread counts, and the most recent 32 handler errors and
+// notifications with methods this SDK does not know. Handler errors are
+// recorded whether or not WithHandlerErrorCallback is set, by method and error
+// type only.
+func (c *Client) DiagnosticsDump() DiagnosticsReport {
+	report := DiagnosticsReport{
+		GeneratedAt:    time.Now(),
+		GoVersion:      runtime.Version(),
+		SDKVersion:     sdkVersion(),
+		MethodStats:    c.MethodStats(),
+		Listeners:      c.RegisteredNotifications(),
+		ConfigWarnings: len(c.ConfigWarnings()),
+	}
+
+	c.initializeMu.Lock()
+	if c.initializeDone {
+		report.Initialized = true
+		clientInfo := cloneInitializeParams(c.initializeParams).ClientInfo
+		report.Client = &clientInfo
+		report.Server = &DiagnosticsServerInfo{
+			UserAgent:      c.initializeResp.UserAgent,
+			PlatformFamily: c.initializeResp.PlatformFamily,
+			PlatformOS:     c.initializeResp.PlatformOS,
+		}
+	}
+	c.initializeMu.Unlock()
+
+	c.threadStateMu.RLock()
+	report.Threads.Snapshots = len(c.threadStates)
+	c.threadStateMu.RUnlock()
+	c.threadUsageMu.Lock()
+	report.Threads.Usage = len(c.threadUsages)
+	c.threadUsageMu.Unlock()
+
+	report.RecentHandlerErrors = c.diagnostics.handlerErrors.snapshot()
+	report.RecentUnknownNotifications = c.diagnostics.unknownNotifications.snapshot()
+	return report
+}
+
+type clientDiagnostics struct {
+	handlerErrors        diagnosticEventLog
+	unknownNotifications diagnosticEventLog
+}
+
+type diagnosticEventLog struct {
+	mu     sync.Mutex
+	events []DiagnosticEvent
+}
+
+func (l *diagnosticEventLog) record(method, errorType string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.events = append(l.events, DiagnosticEvent{Time: time.Now(), Method: method, ErrorType: errorType})
+	if len(l.events) > maxDiagnosticEvents {
+		l.events = append(l.events[:0], l.events[len(l.events)-maxDiagnosticEvents:]...)
+	}
+}
+
+func (l *diagnosticEventLog) snapshot() []DiagnosticEvent {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]DiagnosticEvent{}, l.events...)
+}
+
+func sdkVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return ""
+	}
+	if info.Main.Path == sdkModulePath {
+		return info.Main.Version
+	}
+	for _, dep := range info.Deps {
+		if dep.Path == sdkModulePath {
+			return dep.Version
+		}
+	}
+	return ""
+}
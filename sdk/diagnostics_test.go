@@ -0,0 +1,74 @@
+package codex_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	codex "github.com/dominicnunez/codex-sdk-go/sdk"
+)
+
+func TestDiagnosticsDumpCollectsClientState(t *testing.T) {
+	ctx := context.Background()
+	mock := NewMockTransport()
+	client := codex.NewClient(mock, codex.WithHandlerErrorCallback(func(string, error) {}))
+
+	_ = mock.SetResponseData("initialize", map[string]interface{}{
+		"codexHome":      "/home/alice/.codex",
+		"platformFamily": "unix",
+		"platformOs":     "linux",
+		"userAgent":      "codex_cli_rs/1.2.3",
+	})
+	if _, err := client.Initialize(ctx, codex.InitializeParams{
+		ClientInfo: codex.ClientInfo{Name: "support-test", Version: "0.1.0"},
+	}); err != nil {
+		t.Fatalf("Initialize() error = %v", err)
+	}
+
+	client.OnTurnCompleted(func(codex.TurnCompletedNotification) {})
+	client.OnNotification("future/panics", func(context.Context, codex.Notification) {
+		panic("listener bug")
+	})
+	mock.InjectServerNotification(ctx, codex.Notification{
+		JSONRPC: "2.0",
+		Method:  "future/panics",
+		Params:  json.RawMessage(`{"accessToken":"sk-live"}`),
+	})
+
+	report := client.DiagnosticsDump()
+	if !report.Initialized || report.Client == nil || report.Client.Name != "support-test" {
+		t.Fatalf("report client = %+v, initialized %v; want the initialize client info", report.Client, report.Initialized)
+	}
+	if report.Server == nil || report.Server.UserAgent != "codex_cli_rs/1.2.3" {
+		t.Fatalf("report server = %+v; want the initialize user agent", report.Server)
+	}
+	if stat := report.MethodStats["initialize"]; stat.Count != 1 {
+		t.Errorf("MethodStats[initialize].Count = %d; want 1", stat.Count)
+	}
+	if len(report.RecentUnknownNotifications) != 1 || report.RecentUnknownNotifications[0].Method != "future/panics" {
+		t.Errorf("RecentUnknownNotifications = %+v; want future/panics", report.RecentUnknownNotifications)
+	}
+	if len(report.RecentHandlerErrors) != 1 || report.RecentHandlerErrors[0].ErrorType != "*codex.HandlerError" {
+		t.Errorf("RecentHandlerErrors = %+v; want the listener panic", report.RecentHandlerErrors)
+	}
+	if strings.Join(report.Listeners, ",") != "future/panics,turn/completed" {
+		t.Errorf("Listeners = %v", report.Listeners)
+	}
+
+	var buf bytes.Buffer
+	n, err := report.WriteTo(&buf)
+	if err != nil || n != int64(buf.Len()) {
+		t.Fatalf("WriteTo() = %d, %v; want %d bytes written", n, err, buf.Len())
+	}
+	for _, secret := range []string{"sk-live", "/home/alice", "listener bug"} {
+		if strings.Contains(buf.String(), secret) {
+			t.Errorf("report leaks %q:\n%s", secret, buf.String())
+		}
+	}
+	var decoded map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatalf("report is not valid JSON: %v", err)
+	}
+}
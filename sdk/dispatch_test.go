@@ -3,6 +3,7 @@ package codex_test
 import (
 	"context"
 	"encoding/json"
+	"slices"
 	"strings"
 	"testing"
 
@@ -180,6 +181,21 @@ func TestKnownNotificationDispatch(t *testing.T) {
 			},
 			expectCalled: true,
 		},
+		{
+			name:   "windows/worldWritableWarning",
+			method: "windows/worldWritableWarning",
+			register: func(client *codex.Client, called *bool) {
+				client.OnWindowsWorldWritableWarning(func(n codex.WindowsWorldWritableWarningNotification) {
+					*called = slices.Equal(n.SamplePaths, []string{`C:\tools`, `C:\cache`}) && n.ExtraCount == 3
+				})
+			},
+			params: map[string]interface{}{
+				"extraCount":  3,
+				"failedScan":  false,
+				"samplePaths": []string{`C:\tools`, `C:\cache`},
+			},
+			expectCalled: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -764,6 +780,95 @@ func TestUnknownNotificationIgnored(t *testing.T) {
 	}
 }
 
+// TestOnUnknownNotificationReceivesUnrecognizedMethods verifies that the
+// unknown-notification observer sees methods the SDK has no type for, and
+// only those.
+func TestOnUnknownNotificationReceivesUnrecognizedMethods(t *testing.T) {
+	ctx := context.Background()
+	mock := NewMockTransport()
+	client := codex.NewClient(mock)
+
+	var gotMethods []string
+	var gotParams []string
+	client.OnUnknownNotification(func(method string, params json.RawMessage) {
+		gotMethods = append(gotMethods, method)
+		gotParams = append(gotParams, string(params))
+	})
+
+	mock.InjectServerNotification(ctx, codex.Notification{
+		JSONRPC: "2.0",
+		Method:  "future/feature",
+		Params:  json.RawMessage(`{"data":"test"}`),
+	})
+	mock.InjectServerNotification(ctx, codex.Notification{
+		JSONRPC: "2.0",
+		Method:  "thread/closed",
+		Params:  json.RawMessage(`{"threadId":"thread-1"}`),
+	})
+
+	if len(gotMethods) != 1 || gotMethods[0] != "future/feature" {
+		t.Fatalf("unknown methods = %v; want [future/feature]", gotMethods)
+	}
+	if gotParams[0] != `{"data":"test"}` {
+		t.Fatalf("unknown params = %s; want raw params", gotParams[0])
+	}
+
+	client.OnUnknownNotification(nil)
+	mock.InjectServerNotification(ctx, codex.Notification{
+		JSONRPC: "2.0",
+		Method:  "future/feature",
+		Params:  json.RawMessage(`{}`),
+	})
+	if len(gotMethods) != 1 {
+		t.Fatalf("unknown handler called after being cleared: %v", gotMethods)
+	}
+}
+
+// TestOnMethodCoexistsWithTypedListeners verifies that OnMethod receives raw
+// params alongside typed listeners, recovers panics, and can be removed.
+func TestOnMethodCoexistsWithTypedListeners(t *testing.T) {
+	ctx := context.Background()
+	mock := NewMockTransport()
+	var handlerErrors []string
+	client := codex.NewClient(mock, codex.WithHandlerErrorCallback(func(method string, _ error) {
+		handlerErrors = append(handlerErrors, method)
+	}))
+
+	var typed []string
+	client.OnThreadClosed(func(n codex.ThreadClosedNotification) {
+		typed = append(typed, n.ThreadID)
+	})
+	var raw []string
+	remove := client.OnMethod("thread/closed", func(_ context.Context, params json.RawMessage) {
+		raw = append(raw, string(params))
+	})
+	client.OnMethod("thread/closed", func(context.Context, json.RawMessage) {
+		panic("boom")
+	})
+
+	closed := codex.Notification{JSONRPC: "2.0", Method: "thread/closed", Params: json.RawMessage(`{"threadId":"thread-1"}`)}
+	mock.InjectServerNotification(ctx, closed)
+
+	if len(typed) != 1 || typed[0] != "thread-1" {
+		t.Fatalf("typed listener calls = %v; want [thread-1]", typed)
+	}
+	if len(raw) != 1 || raw[0] != `{"threadId":"thread-1"}` {
+		t.Fatalf("OnMethod params = %v; want raw params", raw)
+	}
+	if len(handlerErrors) != 1 || handlerErrors[0] != "thread/closed" {
+		t.Fatalf("handler errors = %v; want panic reported for thread/closed", handlerErrors)
+	}
+
+	remove()
+	mock.InjectServerNotification(ctx, closed)
+	if len(raw) != 1 {
+		t.Fatalf("OnMethod handler called after removal: %v", raw)
+	}
+	if len(typed) != 2 {
+		t.Fatalf("typed listener calls = %v; want it unaffected by removal", typed)
+	}
+}
+
 // TestUnknownRequestReturnsMethodNotFound verifies that unknown server→client
 // request methods return a JSON-RPC method-not-found error.
 func TestUnknownRequestReturnsMethodNotFound(t *testing.T) {
@@ -1287,3 +1392,32 @@ func TestNilHandlerDeregistration(t *testing.T) {
 		})
 	}
 }
+
+// TestRegisteredNotificationsListsCallerListeners verifies that
+// RegisteredNotifications reports caller-registered methods only, and drops
+// them once their listeners are removed.
+func TestRegisteredNotificationsListsCallerListeners(t *testing.T) {
+	client := codex.NewClient(NewMockTransport())
+
+	if got := client.RegisteredNotifications(); len(got) != 0 {
+		t.Fatalf("RegisteredNotifications() on a new client = %v; want none", got)
+	}
+
+	client.OnTurnCompleted(func(codex.TurnCompletedNotification) {})
+	removeMethod := client.OnMethod("future/feature", func(context.Context, json.RawMessage) {})
+	removeListener := client.AddNotificationListener("item/agentMessage/delta", func(context.Context, codex.Notification) {})
+	client.OnMethod("turn/completed", func(context.Context, json.RawMessage) {})
+
+	want := []string{"future/feature", "item/agentMessage/delta", "turn/completed"}
+	if got := client.RegisteredNotifications(); !slices.Equal(got, want) {
+		t.Fatalf("RegisteredNotifications() = %v; want %v", got, want)
+	}
+
+	removeMethod()
+	removeListener()
+	client.OnTurnCompleted(nil)
+	want = []string{"turn/completed"}
+	if got := client.RegisteredNotifications(); !slices.Equal(got, want) {
+		t.Fatalf("RegisteredNotifications() after removal = %v; want %v", got, want)
+	}
+}
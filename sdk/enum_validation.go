@@ -47,3 +47,11 @@ func marshalEnumString[T ~string](field string, value T, allowed map[T]struct{})
 	}
 	return json.Marshal(string(value))
 }
+
+func newEnumSet[T ~string](values ...T) map[T]struct{} {
+	set := make(map[T]struct{}, len(values))
+	for _, value := range values {
+		set[value] = struct{}{}
+	}
+	return set
+}
@@ -2,6 +2,11 @@ package codex_test
 
 import (
 	"encoding/json"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"slices"
 	"strings"
 	"testing"
 
@@ -303,3 +308,114 @@ func TestProtocolEnumsRejectInvalidMarshalAndUnmarshal(t *testing.T) {
 		})
 	}
 }
+
+func TestEnumsExposeValuesAndValid(t *testing.T) {
+	paths, err := filepath.Glob(repoPath(t, "sdk", "*.go"))
+	if err != nil {
+		t.Fatalf("glob sdk sources: %v", err)
+	}
+
+	fset := token.NewFileSet()
+	stringTypes := make(map[string]bool)
+	constCounts := make(map[string]int)
+	funcs := make(map[string]bool)
+	validMethods := make(map[string]bool)
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			t.Fatalf("parse %s: %v", filepath.Base(path), err)
+		}
+		for _, decl := range file.Decls {
+			switch d := decl.(type) {
+			case *ast.GenDecl:
+				for _, spec := range d.Specs {
+					switch s := spec.(type) {
+					case *ast.TypeSpec:
+						if ident, ok := s.Type.(*ast.Ident); ok && ident.Name == "string" && s.Name.IsExported() {
+							stringTypes[s.Name.Name] = true
+						}
+					case *ast.ValueSpec:
+						if ident, ok := s.Type.(*ast.Ident); ok && d.Tok == token.CONST {
+							constCounts[ident.Name] += len(s.Names)
+						}
+					}
+				}
+			case *ast.FuncDecl:
+				if d.Recv == nil {
+					funcs[d.Name.Name] = true
+					continue
+				}
+				if d.Name.Name != "Valid" {
+					continue
+				}
+				if ident, ok := d.Recv.List[0].Type.(*ast.Ident); ok {
+					validMethods[ident.Name] = true
+				}
+			}
+		}
+	}
+
+	// A string type with declared constants is an enum.
+	var enumTypes []string
+	for name := range stringTypes {
+		if constCounts[name] > 0 {
+			enumTypes = append(enumTypes, name)
+		}
+	}
+	if len(enumTypes) == 0 {
+		t.Fatal("found no string enum types in sdk")
+	}
+	slices.Sort(enumTypes)
+	for _, name := range enumTypes {
+		if !funcs[name+"Values"] {
+			t.Errorf("%s is missing %sValues()", name, name)
+		}
+		if !validMethods[name] {
+			t.Errorf("%s is missing a value-receiver Valid() method", name)
+		}
+	}
+}
+
+func TestEnumValuesAndValid(t *testing.T) {
+	efforts := codex.ReasoningEffortValues()
+	if !slices.Contains(efforts, codex.ReasoningEffortXHigh) {
+		t.Fatalf("ReasoningEffortValues() = %v; missing xhigh", efforts)
+	}
+	for _, effort := range efforts {
+		if !effort.Valid() {
+			t.Fatalf("%q.Valid() = false", effort)
+		}
+	}
+	if codex.ReasoningEffort("extreme").Valid() {
+		t.Fatal(`ReasoningEffort("extreme").Valid() = true`)
+	}
+
+	modes := codex.SandboxModeValues()
+	want := []codex.SandboxMode{
+		codex.SandboxModeReadOnly,
+		codex.SandboxModeWorkspaceWrite,
+		codex.SandboxModeDangerFullAccess,
+	}
+	if !slices.Equal(modes, want) {
+		t.Fatalf("SandboxModeValues() = %v; want %v", modes, want)
+	}
+	modes[0] = "mutated"
+	if codex.SandboxModeValues()[0] != codex.SandboxModeReadOnly {
+		t.Fatal("SandboxModeValues() returned shared backing storage")
+	}
+
+	if !codex.PersonalityPragmatic.Valid() || codex.Personality("").Valid() {
+		t.Fatal("Personality.Valid() mismatch")
+	}
+
+	plans := codex.PlanTypeValues()
+	if !slices.Contains(plans, codex.PlanTypePro) {
+		t.Fatalf("PlanTypeValues() = %v; missing pro", plans)
+	}
+	if !codex.CommandExecutionStatusCompleted.Valid() || codex.CommandExecutionStatus("paused").Valid() {
+		t.Fatal("CommandExecutionStatus.Valid() mismatch")
+	}
+}
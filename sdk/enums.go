@@ -1,6 +1,9 @@
 package codex
 
-import "fmt"
+import (
+	"fmt"
+	"slices"
+)
 
 // This file contains typed string enums defined in the protocol spec that are
 // referenced by fields across multiple domain files.
@@ -15,11 +18,24 @@ const (
 	TurnStatusInProgress  TurnStatus = "inProgress"
 )
 
-var validTurnStatuses = map[TurnStatus]struct{}{
-	TurnStatusCompleted:   {},
-	TurnStatusInterrupted: {},
-	TurnStatusFailed:      {},
-	TurnStatusInProgress:  {},
+var allTurnStatuses = []TurnStatus{
+	TurnStatusCompleted,
+	TurnStatusInterrupted,
+	TurnStatusFailed,
+	TurnStatusInProgress,
+}
+
+var validTurnStatuses = newEnumSet(allTurnStatuses...)
+
+// TurnStatusValues returns every known TurnStatus value in protocol order.
+func TurnStatusValues() []TurnStatus {
+	return slices.Clone(allTurnStatuses)
+}
+
+// Valid reports whether s is a known TurnStatus value.
+func (s TurnStatus) Valid() bool {
+	_, ok := validTurnStatuses[s]
+	return ok
 }
 
 func (s *TurnStatus) UnmarshalJSON(data []byte) error {
@@ -35,6 +51,25 @@ const (
 	TurnAbortReasonReviewEnded TurnAbortReason = "review_ended"
 )
 
+var allTurnAbortReasons = []TurnAbortReason{
+	TurnAbortReasonInterrupted,
+	TurnAbortReasonReplaced,
+	TurnAbortReasonReviewEnded,
+}
+
+var validTurnAbortReasons = newEnumSet(allTurnAbortReasons...)
+
+// TurnAbortReasonValues returns every known TurnAbortReason value in protocol order.
+func TurnAbortReasonValues() []TurnAbortReason {
+	return slices.Clone(allTurnAbortReasons)
+}
+
+// Valid reports whether t is a known TurnAbortReason value.
+func (t TurnAbortReason) Valid() bool {
+	_, ok := validTurnAbortReasons[t]
+	return ok
+}
+
 // Personality represents the assistant personality style.
 type Personality string
 
@@ -44,10 +79,23 @@ const (
 	PersonalityPragmatic Personality = "pragmatic"
 )
 
-var validPersonalities = map[Personality]struct{}{
-	PersonalityNone:      {},
-	PersonalityFriendly:  {},
-	PersonalityPragmatic: {},
+var allPersonalities = []Personality{
+	PersonalityNone,
+	PersonalityFriendly,
+	PersonalityPragmatic,
+}
+
+var validPersonalities = newEnumSet(allPersonalities...)
+
+// PersonalityValues returns every known Personality value in protocol order.
+func PersonalityValues() []Personality {
+	return slices.Clone(allPersonalities)
+}
+
+// Valid reports whether p is a known Personality value.
+func (p Personality) Valid() bool {
+	_, ok := validPersonalities[p]
+	return ok
 }
 
 func (p Personality) MarshalJSON() ([]byte, error) {
@@ -67,10 +115,23 @@ const (
 	ApprovalsReviewerGuardianSubagent ApprovalsReviewer = "guardian_subagent"
 )
 
-var validApprovalsReviewers = map[ApprovalsReviewer]struct{}{
-	ApprovalsReviewerUser:             {},
-	ApprovalsReviewerAutoReview:       {},
-	ApprovalsReviewerGuardianSubagent: {},
+var allApprovalsReviewers = []ApprovalsReviewer{
+	ApprovalsReviewerUser,
+	ApprovalsReviewerAutoReview,
+	ApprovalsReviewerGuardianSubagent,
+}
+
+var validApprovalsReviewers = newEnumSet(allApprovalsReviewers...)
+
+// ApprovalsReviewerValues returns every known ApprovalsReviewer value in protocol order.
+func ApprovalsReviewerValues() []ApprovalsReviewer {
+	return slices.Clone(allApprovalsReviewers)
+}
+
+// Valid reports whether r is a known ApprovalsReviewer value.
+func (r ApprovalsReviewer) Valid() bool {
+	_, ok := validApprovalsReviewers[r]
+	return ok
 }
 
 func (r ApprovalsReviewer) MarshalJSON() ([]byte, error) {
@@ -89,9 +150,22 @@ const (
 	ServiceTierFlex ServiceTier = "flex"
 )
 
-var validServiceTiers = map[ServiceTier]struct{}{
-	ServiceTierFast: {},
-	ServiceTierFlex: {},
+var allServiceTiers = []ServiceTier{
+	ServiceTierFast,
+	ServiceTierFlex,
+}
+
+var validServiceTiers = newEnumSet(allServiceTiers...)
+
+// ServiceTierValues returns every known ServiceTier value in protocol order.
+func ServiceTierValues() []ServiceTier {
+	return slices.Clone(allServiceTiers)
+}
+
+// Valid reports whether s is a known ServiceTier value.
+func (s ServiceTier) Valid() bool {
+	_, ok := validServiceTiers[s]
+	return ok
 }
 
 func (s ServiceTier) MarshalJSON() ([]byte, error) {
@@ -110,9 +184,22 @@ const (
 	ModeKindDefault ModeKind = "default"
 )
 
-var validModeKinds = map[ModeKind]struct{}{
-	ModeKindPlan:    {},
-	ModeKindDefault: {},
+var allModeKinds = []ModeKind{
+	ModeKindPlan,
+	ModeKindDefault,
+}
+
+var validModeKinds = newEnumSet(allModeKinds...)
+
+// ModeKindValues returns every known ModeKind value in protocol order.
+func ModeKindValues() []ModeKind {
+	return slices.Clone(allModeKinds)
+}
+
+// Valid reports whether m is a known ModeKind value.
+func (m ModeKind) Valid() bool {
+	_, ok := validModeKinds[m]
+	return ok
 }
 
 func (m ModeKind) MarshalJSON() ([]byte, error) {
@@ -131,9 +218,22 @@ const (
 	MergeStrategyUpsert  MergeStrategy = "upsert"
 )
 
-var validMergeStrategies = map[MergeStrategy]struct{}{
-	MergeStrategyReplace: {},
-	MergeStrategyUpsert:  {},
+var allMergeStrategies = []MergeStrategy{
+	MergeStrategyReplace,
+	MergeStrategyUpsert,
+}
+
+var validMergeStrategies = newEnumSet(allMergeStrategies...)
+
+// MergeStrategyValues returns every known MergeStrategy value in protocol order.
+func MergeStrategyValues() []MergeStrategy {
+	return slices.Clone(allMergeStrategies)
+}
+
+// Valid reports whether m is a known MergeStrategy value.
+func (m MergeStrategy) Valid() bool {
+	_, ok := validMergeStrategies[m]
+	return ok
 }
 
 func (m MergeStrategy) MarshalJSON() ([]byte, error) {
@@ -153,10 +253,23 @@ const (
 	VerbosityHigh   Verbosity = "high"
 )
 
-var validVerbosityLevels = map[Verbosity]struct{}{
-	VerbosityLow:    {},
-	VerbosityMedium: {},
-	VerbosityHigh:   {},
+var allVerbosityLevels = []Verbosity{
+	VerbosityLow,
+	VerbosityMedium,
+	VerbosityHigh,
+}
+
+var validVerbosityLevels = newEnumSet(allVerbosityLevels...)
+
+// VerbosityValues returns every known Verbosity value in protocol order.
+func VerbosityValues() []Verbosity {
+	return slices.Clone(allVerbosityLevels)
+}
+
+// Valid reports whether v is a known Verbosity value.
+func (v Verbosity) Valid() bool {
+	_, ok := validVerbosityLevels[v]
+	return ok
 }
 
 func (v Verbosity) MarshalJSON() ([]byte, error) {
@@ -176,10 +289,23 @@ const (
 	SandboxModeDangerFullAccess SandboxMode = "danger-full-access"
 )
 
-var validSandboxModes = map[SandboxMode]struct{}{
-	SandboxModeReadOnly:         {},
-	SandboxModeWorkspaceWrite:   {},
-	SandboxModeDangerFullAccess: {},
+var allSandboxModes = []SandboxMode{
+	SandboxModeReadOnly,
+	SandboxModeWorkspaceWrite,
+	SandboxModeDangerFullAccess,
+}
+
+var validSandboxModes = newEnumSet(allSandboxModes...)
+
+// SandboxModeValues returns every known SandboxMode value in protocol order.
+func SandboxModeValues() []SandboxMode {
+	return slices.Clone(allSandboxModes)
+}
+
+// Valid reports whether m is a known SandboxMode value.
+func (m SandboxMode) Valid() bool {
+	_, ok := validSandboxModes[m]
+	return ok
 }
 
 func (m SandboxMode) MarshalJSON() ([]byte, error) {
@@ -199,10 +325,23 @@ const (
 	WebSearchModeLive     WebSearchMode = "live"
 )
 
-var validWebSearchModes = map[WebSearchMode]struct{}{
-	WebSearchModeDisabled: {},
-	WebSearchModeCached:   {},
-	WebSearchModeLive:     {},
+var allWebSearchModes = []WebSearchMode{
+	WebSearchModeDisabled,
+	WebSearchModeCached,
+	WebSearchModeLive,
+}
+
+var validWebSearchModes = newEnumSet(allWebSearchModes...)
+
+// WebSearchModeValues returns every known WebSearchMode value in protocol order.
+func WebSearchModeValues() []WebSearchMode {
+	return slices.Clone(allWebSearchModes)
+}
+
+// Valid reports whether m is a known WebSearchMode value.
+func (m WebSearchMode) Valid() bool {
+	_, ok := validWebSearchModes[m]
+	return ok
 }
 
 func (m WebSearchMode) MarshalJSON() ([]byte, error) {
@@ -221,9 +360,22 @@ const (
 	WriteStatusOKOverridden WriteStatus = "okOverridden"
 )
 
-var validWriteStatuses = map[WriteStatus]struct{}{
-	WriteStatusOK:           {},
-	WriteStatusOKOverridden: {},
+var allWriteStatuses = []WriteStatus{
+	WriteStatusOK,
+	WriteStatusOKOverridden,
+}
+
+var validWriteStatuses = newEnumSet(allWriteStatuses...)
+
+// WriteStatusValues returns every known WriteStatus value in protocol order.
+func WriteStatusValues() []WriteStatus {
+	return slices.Clone(allWriteStatuses)
+}
+
+// Valid reports whether w is a known WriteStatus value.
+func (w WriteStatus) Valid() bool {
+	_, ok := validWriteStatuses[w]
+	return ok
 }
 
 func validateWriteStatusField(field string, value WriteStatus) error {
@@ -240,11 +392,24 @@ const (
 	NetworkApprovalProtocolSocks5UDP NetworkApprovalProtocol = "socks5Udp"
 )
 
-var validNetworkApprovalProtocols = map[NetworkApprovalProtocol]struct{}{
-	NetworkApprovalProtocolHTTP:      {},
-	NetworkApprovalProtocolHTTPS:     {},
-	NetworkApprovalProtocolSocks5TCP: {},
-	NetworkApprovalProtocolSocks5UDP: {},
+var allNetworkApprovalProtocols = []NetworkApprovalProtocol{
+	NetworkApprovalProtocolHTTP,
+	NetworkApprovalProtocolHTTPS,
+	NetworkApprovalProtocolSocks5TCP,
+	NetworkApprovalProtocolSocks5UDP,
+}
+
+var validNetworkApprovalProtocols = newEnumSet(allNetworkApprovalProtocols...)
+
+// NetworkApprovalProtocolValues returns every known NetworkApprovalProtocol value in protocol order.
+func NetworkApprovalProtocolValues() []NetworkApprovalProtocol {
+	return slices.Clone(allNetworkApprovalProtocols)
+}
+
+// Valid reports whether n is a known NetworkApprovalProtocol value.
+func (n NetworkApprovalProtocol) Valid() bool {
+	_, ok := validNetworkApprovalProtocols[n]
+	return ok
 }
 
 func validateNetworkApprovalProtocolField(field string, value NetworkApprovalProtocol) error {
@@ -259,6 +424,24 @@ const (
 	NetworkPolicyRuleActionDeny  NetworkPolicyRuleAction = "deny"
 )
 
+var allNetworkPolicyRuleActions = []NetworkPolicyRuleAction{
+	NetworkPolicyRuleActionAllow,
+	NetworkPolicyRuleActionDeny,
+}
+
+var validNetworkPolicyRuleActions = newEnumSet(allNetworkPolicyRuleActions...)
+
+// NetworkPolicyRuleActionValues returns every known NetworkPolicyRuleAction value in protocol order.
+func NetworkPolicyRuleActionValues() []NetworkPolicyRuleAction {
+	return slices.Clone(allNetworkPolicyRuleActions)
+}
+
+// Valid reports whether n is a known NetworkPolicyRuleAction value.
+func (n NetworkPolicyRuleAction) Valid() bool {
+	_, ok := validNetworkPolicyRuleActions[n]
+	return ok
+}
+
 func validateNetworkPolicyRuleAction(action NetworkPolicyRuleAction) error {
 	switch action {
 	case NetworkPolicyRuleActionAllow, NetworkPolicyRuleActionDeny:
@@ -278,6 +461,26 @@ const (
 	ExecCommandSourceUnifiedExecInteraction ExecCommandSource = "unified_exec_interaction"
 )
 
+var allExecCommandSources = []ExecCommandSource{
+	ExecCommandSourceAgent,
+	ExecCommandSourceUserShell,
+	ExecCommandSourceUnifiedExecStartup,
+	ExecCommandSourceUnifiedExecInteraction,
+}
+
+var validExecCommandSources = newEnumSet(allExecCommandSources...)
+
+// ExecCommandSourceValues returns every known ExecCommandSource value in protocol order.
+func ExecCommandSourceValues() []ExecCommandSource {
+	return slices.Clone(allExecCommandSources)
+}
+
+// Valid reports whether e is a known ExecCommandSource value.
+func (e ExecCommandSource) Valid() bool {
+	_, ok := validExecCommandSources[e]
+	return ok
+}
+
 // ExecCommandStatus represents the status of a legacy exec command.
 type ExecCommandStatus string
 
@@ -287,6 +490,25 @@ const (
 	ExecCommandStatusDeclined  ExecCommandStatus = "declined"
 )
 
+var allExecCommandStatuses = []ExecCommandStatus{
+	ExecCommandStatusCompleted,
+	ExecCommandStatusFailed,
+	ExecCommandStatusDeclined,
+}
+
+var validExecCommandStatuses = newEnumSet(allExecCommandStatuses...)
+
+// ExecCommandStatusValues returns every known ExecCommandStatus value in protocol order.
+func ExecCommandStatusValues() []ExecCommandStatus {
+	return slices.Clone(allExecCommandStatuses)
+}
+
+// Valid reports whether e is a known ExecCommandStatus value.
+func (e ExecCommandStatus) Valid() bool {
+	_, ok := validExecCommandStatuses[e]
+	return ok
+}
+
 // ExecOutputStream represents the output stream of a command.
 type ExecOutputStream string
 
@@ -295,6 +517,24 @@ const (
 	ExecOutputStreamStderr ExecOutputStream = "stderr"
 )
 
+var allExecOutputStreams = []ExecOutputStream{
+	ExecOutputStreamStdout,
+	ExecOutputStreamStderr,
+}
+
+var validExecOutputStreams = newEnumSet(allExecOutputStreams...)
+
+// ExecOutputStreamValues returns every known ExecOutputStream value in protocol order.
+func ExecOutputStreamValues() []ExecOutputStream {
+	return slices.Clone(allExecOutputStreams)
+}
+
+// Valid reports whether e is a known ExecOutputStream value.
+func (e ExecOutputStream) Valid() bool {
+	_, ok := validExecOutputStreams[e]
+	return ok
+}
+
 // LocalShellStatus represents the status of a local shell execution.
 type LocalShellStatus string
 
@@ -304,6 +544,25 @@ const (
 	LocalShellStatusIncomplete LocalShellStatus = "incomplete"
 )
 
+var allLocalShellStatuses = []LocalShellStatus{
+	LocalShellStatusCompleted,
+	LocalShellStatusInProgress,
+	LocalShellStatusIncomplete,
+}
+
+var validLocalShellStatuses = newEnumSet(allLocalShellStatuses...)
+
+// LocalShellStatusValues returns every known LocalShellStatus value in protocol order.
+func LocalShellStatusValues() []LocalShellStatus {
+	return slices.Clone(allLocalShellStatuses)
+}
+
+// Valid reports whether l is a known LocalShellStatus value.
+func (l LocalShellStatus) Valid() bool {
+	_, ok := validLocalShellStatuses[l]
+	return ok
+}
+
 // ThreadActiveFlag represents the active status flag of a thread.
 type ThreadActiveFlag string
 
@@ -312,9 +571,22 @@ const (
 	ThreadActiveFlagWaitingOnUserInput ThreadActiveFlag = "waitingOnUserInput"
 )
 
-var validThreadActiveFlags = map[ThreadActiveFlag]struct{}{
-	ThreadActiveFlagWaitingOnApproval:  {},
-	ThreadActiveFlagWaitingOnUserInput: {},
+var allThreadActiveFlags = []ThreadActiveFlag{
+	ThreadActiveFlagWaitingOnApproval,
+	ThreadActiveFlagWaitingOnUserInput,
+}
+
+var validThreadActiveFlags = newEnumSet(allThreadActiveFlags...)
+
+// ThreadActiveFlagValues returns every known ThreadActiveFlag value in protocol order.
+func ThreadActiveFlagValues() []ThreadActiveFlag {
+	return slices.Clone(allThreadActiveFlags)
+}
+
+// Valid reports whether f is a known ThreadActiveFlag value.
+func (f ThreadActiveFlag) Valid() bool {
+	_, ok := validThreadActiveFlags[f]
+	return ok
 }
 
 func (f *ThreadActiveFlag) UnmarshalJSON(data []byte) error {
@@ -330,6 +602,25 @@ const (
 	ThreadUnsubscribeStatusUnsubscribed  ThreadUnsubscribeStatus = "unsubscribed"
 )
 
+var allThreadUnsubscribeStatuses = []ThreadUnsubscribeStatus{
+	ThreadUnsubscribeStatusNotLoaded,
+	ThreadUnsubscribeStatusNotSubscribed,
+	ThreadUnsubscribeStatusUnsubscribed,
+}
+
+var validThreadUnsubscribeStatuses = newEnumSet(allThreadUnsubscribeStatuses...)
+
+// ThreadUnsubscribeStatusValues returns every known ThreadUnsubscribeStatus value in protocol order.
+func ThreadUnsubscribeStatusValues() []ThreadUnsubscribeStatus {
+	return slices.Clone(allThreadUnsubscribeStatuses)
+}
+
+// Valid reports whether t is a known ThreadUnsubscribeStatus value.
+func (t ThreadUnsubscribeStatus) Valid() bool {
+	_, ok := validThreadUnsubscribeStatuses[t]
+	return ok
+}
+
 // ThreadSortKey represents the sort key for thread listing.
 type ThreadSortKey string
 
@@ -338,9 +629,22 @@ const (
 	ThreadSortKeyUpdatedAt ThreadSortKey = "updated_at"
 )
 
-var validThreadSortKeys = map[ThreadSortKey]struct{}{
-	ThreadSortKeyCreatedAt: {},
-	ThreadSortKeyUpdatedAt: {},
+var allThreadSortKeys = []ThreadSortKey{
+	ThreadSortKeyCreatedAt,
+	ThreadSortKeyUpdatedAt,
+}
+
+var validThreadSortKeys = newEnumSet(allThreadSortKeys...)
+
+// ThreadSortKeyValues returns every known ThreadSortKey value in protocol order.
+func ThreadSortKeyValues() []ThreadSortKey {
+	return slices.Clone(allThreadSortKeys)
+}
+
+// Valid reports whether k is a known ThreadSortKey value.
+func (k ThreadSortKey) Valid() bool {
+	_, ok := validThreadSortKeys[k]
+	return ok
 }
 
 func (k ThreadSortKey) MarshalJSON() ([]byte, error) {
@@ -367,17 +671,30 @@ const (
 	ThreadSourceKindUnknown             ThreadSourceKind = "unknown"
 )
 
-var validThreadSourceKinds = map[ThreadSourceKind]struct{}{
-	ThreadSourceKindCLI:                 {},
-	ThreadSourceKindVSCode:              {},
-	ThreadSourceKindExec:                {},
-	ThreadSourceKindAppServer:           {},
-	ThreadSourceKindSubAgent:            {},
-	ThreadSourceKindSubAgentReview:      {},
-	ThreadSourceKindSubAgentCompact:     {},
-	ThreadSourceKindSubAgentThreadSpawn: {},
-	ThreadSourceKindSubAgentOther:       {},
-	ThreadSourceKindUnknown:             {},
+var allThreadSourceKinds = []ThreadSourceKind{
+	ThreadSourceKindCLI,
+	ThreadSourceKindVSCode,
+	ThreadSourceKindExec,
+	ThreadSourceKindAppServer,
+	ThreadSourceKindSubAgent,
+	ThreadSourceKindSubAgentReview,
+	ThreadSourceKindSubAgentCompact,
+	ThreadSourceKindSubAgentThreadSpawn,
+	ThreadSourceKindSubAgentOther,
+	ThreadSourceKindUnknown,
+}
+
+var validThreadSourceKinds = newEnumSet(allThreadSourceKinds...)
+
+// ThreadSourceKindValues returns every known ThreadSourceKind value in protocol order.
+func ThreadSourceKindValues() []ThreadSourceKind {
+	return slices.Clone(allThreadSourceKinds)
+}
+
+// Valid reports whether k is a known ThreadSourceKind value.
+func (k ThreadSourceKind) Valid() bool {
+	_, ok := validThreadSourceKinds[k]
+	return ok
 }
 
 func (k ThreadSourceKind) MarshalJSON() ([]byte, error) {
@@ -397,10 +714,23 @@ const (
 	ThreadSourceMemoryConsolidation ThreadSource = "memory_consolidation"
 )
 
-var validThreadSources = map[ThreadSource]struct{}{
-	ThreadSourceUser:                {},
-	ThreadSourceSubagent:            {},
-	ThreadSourceMemoryConsolidation: {},
+var allThreadSources = []ThreadSource{
+	ThreadSourceUser,
+	ThreadSourceSubagent,
+	ThreadSourceMemoryConsolidation,
+}
+
+var validThreadSources = newEnumSet(allThreadSources...)
+
+// ThreadSourceValues returns every known ThreadSource value in protocol order.
+func ThreadSourceValues() []ThreadSource {
+	return slices.Clone(allThreadSources)
+}
+
+// Valid reports whether s is a known ThreadSource value.
+func (s ThreadSource) Valid() bool {
+	_, ok := validThreadSources[s]
+	return ok
 }
 
 func (s ThreadSource) MarshalJSON() ([]byte, error) {
@@ -419,9 +749,22 @@ const (
 	ForcedLoginMethodAPI     ForcedLoginMethod = "api"
 )
 
-var validForcedLoginMethods = map[ForcedLoginMethod]struct{}{
-	ForcedLoginMethodChatGPT: {},
-	ForcedLoginMethodAPI:     {},
+var allForcedLoginMethods = []ForcedLoginMethod{
+	ForcedLoginMethodChatGPT,
+	ForcedLoginMethodAPI,
+}
+
+var validForcedLoginMethods = newEnumSet(allForcedLoginMethods...)
+
+// ForcedLoginMethodValues returns every known ForcedLoginMethod value in protocol order.
+func ForcedLoginMethodValues() []ForcedLoginMethod {
+	return slices.Clone(allForcedLoginMethods)
+}
+
+// Valid reports whether m is a known ForcedLoginMethod value.
+func (m ForcedLoginMethod) Valid() bool {
+	_, ok := validForcedLoginMethods[m]
+	return ok
 }
 
 func (m ForcedLoginMethod) MarshalJSON() ([]byte, error) {
@@ -439,8 +782,21 @@ const (
 	ChatgptAuthTokensRefreshReasonUnauthorized ChatgptAuthTokensRefreshReason = "unauthorized"
 )
 
-var validChatgptAuthTokensRefreshReasons = map[ChatgptAuthTokensRefreshReason]struct{}{
-	ChatgptAuthTokensRefreshReasonUnauthorized: {},
+var allChatgptAuthTokensRefreshReasons = []ChatgptAuthTokensRefreshReason{
+	ChatgptAuthTokensRefreshReasonUnauthorized,
+}
+
+var validChatgptAuthTokensRefreshReasons = newEnumSet(allChatgptAuthTokensRefreshReasons...)
+
+// ChatgptAuthTokensRefreshReasonValues returns every known ChatgptAuthTokensRefreshReason value in protocol order.
+func ChatgptAuthTokensRefreshReasonValues() []ChatgptAuthTokensRefreshReason {
+	return slices.Clone(allChatgptAuthTokensRefreshReasons)
+}
+
+// Valid reports whether c is a known ChatgptAuthTokensRefreshReason value.
+func (c ChatgptAuthTokensRefreshReason) Valid() bool {
+	_, ok := validChatgptAuthTokensRefreshReasons[c]
+	return ok
 }
 
 func validateChatgptAuthTokensRefreshReasonField(field string, value ChatgptAuthTokensRefreshReason) error {
@@ -457,11 +813,24 @@ const (
 	FileChangeApprovalDecisionCancel           FileChangeApprovalDecision = "cancel"
 )
 
-var validFileChangeApprovalDecisions = map[FileChangeApprovalDecision]struct{}{
-	FileChangeApprovalDecisionAccept:           {},
-	FileChangeApprovalDecisionAcceptForSession: {},
-	FileChangeApprovalDecisionDecline:          {},
-	FileChangeApprovalDecisionCancel:           {},
+var allFileChangeApprovalDecisions = []FileChangeApprovalDecision{
+	FileChangeApprovalDecisionAccept,
+	FileChangeApprovalDecisionAcceptForSession,
+	FileChangeApprovalDecisionDecline,
+	FileChangeApprovalDecisionCancel,
+}
+
+var validFileChangeApprovalDecisions = newEnumSet(allFileChangeApprovalDecisions...)
+
+// FileChangeApprovalDecisionValues returns every known FileChangeApprovalDecision value in protocol order.
+func FileChangeApprovalDecisionValues() []FileChangeApprovalDecision {
+	return slices.Clone(allFileChangeApprovalDecisions)
+}
+
+// Valid reports whether f is a known FileChangeApprovalDecision value.
+func (f FileChangeApprovalDecision) Valid() bool {
+	_, ok := validFileChangeApprovalDecisions[f]
+	return ok
 }
 
 func validateFileChangeApprovalDecisionField(field string, value FileChangeApprovalDecision) error {
@@ -477,6 +846,25 @@ const (
 	AppToolApprovalApprove AppToolApproval = "approve"
 )
 
+var allAppToolApprovals = []AppToolApproval{
+	AppToolApprovalAuto,
+	AppToolApprovalPrompt,
+	AppToolApprovalApprove,
+}
+
+var validAppToolApprovals = newEnumSet(allAppToolApprovals...)
+
+// AppToolApprovalValues returns every known AppToolApproval value in protocol order.
+func AppToolApprovalValues() []AppToolApproval {
+	return slices.Clone(allAppToolApprovals)
+}
+
+// Valid reports whether a is a known AppToolApproval value.
+func (a AppToolApproval) Valid() bool {
+	_, ok := validAppToolApprovals[a]
+	return ok
+}
+
 // ResidencyRequirement represents a data residency requirement.
 type ResidencyRequirement string
 
@@ -484,8 +872,21 @@ const (
 	ResidencyRequirementUS ResidencyRequirement = "us"
 )
 
-var validResidencyRequirements = map[ResidencyRequirement]struct{}{
-	ResidencyRequirementUS: {},
+var allResidencyRequirements = []ResidencyRequirement{
+	ResidencyRequirementUS,
+}
+
+var validResidencyRequirements = newEnumSet(allResidencyRequirements...)
+
+// ResidencyRequirementValues returns every known ResidencyRequirement value in protocol order.
+func ResidencyRequirementValues() []ResidencyRequirement {
+	return slices.Clone(allResidencyRequirements)
+}
+
+// Valid reports whether r is a known ResidencyRequirement value.
+func (r ResidencyRequirement) Valid() bool {
+	_, ok := validResidencyRequirements[r]
+	return ok
 }
 
 func (r ResidencyRequirement) MarshalJSON() ([]byte, error) {
@@ -508,13 +909,26 @@ const (
 	ReasoningEffortXHigh   ReasoningEffort = "xhigh"
 )
 
-var validReasoningEfforts = map[ReasoningEffort]struct{}{
-	ReasoningEffortNone:    {},
-	ReasoningEffortMinimal: {},
-	ReasoningEffortLow:     {},
-	ReasoningEffortMedium:  {},
-	ReasoningEffortHigh:    {},
-	ReasoningEffortXHigh:   {},
+var allReasoningEfforts = []ReasoningEffort{
+	ReasoningEffortNone,
+	ReasoningEffortMinimal,
+	ReasoningEffortLow,
+	ReasoningEffortMedium,
+	ReasoningEffortHigh,
+	ReasoningEffortXHigh,
+}
+
+var validReasoningEfforts = newEnumSet(allReasoningEfforts...)
+
+// ReasoningEffortValues returns every known ReasoningEffort value in protocol order.
+func ReasoningEffortValues() []ReasoningEffort {
+	return slices.Clone(allReasoningEfforts)
+}
+
+// Valid reports whether r is a known ReasoningEffort value.
+func (r ReasoningEffort) Valid() bool {
+	_, ok := validReasoningEfforts[r]
+	return ok
 }
 
 func (r ReasoningEffort) MarshalJSON() ([]byte, error) {
@@ -533,9 +947,22 @@ const (
 	InputModalityImage InputModality = "image"
 )
 
-var validInputModalities = map[InputModality]struct{}{
-	InputModalityText:  {},
-	InputModalityImage: {},
+var allInputModalities = []InputModality{
+	InputModalityText,
+	InputModalityImage,
+}
+
+var validInputModalities = newEnumSet(allInputModalities...)
+
+// InputModalityValues returns every known InputModality value in protocol order.
+func InputModalityValues() []InputModality {
+	return slices.Clone(allInputModalities)
+}
+
+// Valid reports whether m is a known InputModality value.
+func (m InputModality) Valid() bool {
+	_, ok := validInputModalities[m]
+	return ok
 }
 
 func (m *InputModality) UnmarshalJSON(data []byte) error {
@@ -554,11 +981,24 @@ const (
 	ReasoningSummaryModeNone     ReasoningSummaryMode = "none"
 )
 
-var validReasoningSummaryModes = map[ReasoningSummaryMode]struct{}{
-	ReasoningSummaryModeAuto:     {},
-	ReasoningSummaryModeConcise:  {},
-	ReasoningSummaryModeDetailed: {},
-	ReasoningSummaryModeNone:     {},
+var allReasoningSummaryModes = []ReasoningSummaryMode{
+	ReasoningSummaryModeAuto,
+	ReasoningSummaryModeConcise,
+	ReasoningSummaryModeDetailed,
+	ReasoningSummaryModeNone,
+}
+
+var validReasoningSummaryModes = newEnumSet(allReasoningSummaryModes...)
+
+// ReasoningSummaryModeValues returns every known ReasoningSummaryMode value in protocol order.
+func ReasoningSummaryModeValues() []ReasoningSummaryMode {
+	return slices.Clone(allReasoningSummaryModes)
+}
+
+// Valid reports whether m is a known ReasoningSummaryMode value.
+func (m ReasoningSummaryMode) Valid() bool {
+	_, ok := validReasoningSummaryModes[m]
+	return ok
 }
 
 func (m ReasoningSummaryMode) MarshalJSON() ([]byte, error) {
@@ -568,3 +1008,90 @@ func (m ReasoningSummaryMode) MarshalJSON() ([]byte, error) {
 func (m *ReasoningSummaryMode) UnmarshalJSON(data []byte) error {
 	return unmarshalEnumString(data, "reasoningSummary", validReasoningSummaryModes, m)
 }
+
+// CodexErrorCode identifies the kind of failure reported in a turn error's
+// codexErrorInfo.
+type CodexErrorCode string
+
+const (
+	CodexErrorCodeContextWindowExceeded          CodexErrorCode = "contextWindowExceeded"
+	CodexErrorCodeUsageLimitExceeded             CodexErrorCode = "usageLimitExceeded"
+	CodexErrorCodeServerOverloaded               CodexErrorCode = "serverOverloaded"
+	CodexErrorCodeCyberPolicy                    CodexErrorCode = "cyberPolicy"
+	CodexErrorCodeInternalServerError            CodexErrorCode = "internalServerError"
+	CodexErrorCodeUnauthorized                   CodexErrorCode = "unauthorized"
+	CodexErrorCodeBadRequest                     CodexErrorCode = "badRequest"
+	CodexErrorCodeThreadRollbackFailed           CodexErrorCode = "threadRollbackFailed"
+	CodexErrorCodeSandboxError                   CodexErrorCode = "sandboxError"
+	CodexErrorCodeOther                          CodexErrorCode = "other"
+	CodexErrorCodeHTTPConnectionFailed           CodexErrorCode = "httpConnectionFailed"
+	CodexErrorCodeResponseStreamConnectionFailed CodexErrorCode = "responseStreamConnectionFailed"
+	CodexErrorCodeResponseStreamDisconnected     CodexErrorCode = "responseStreamDisconnected"
+	CodexErrorCodeResponseTooManyFailedAttempts  CodexErrorCode = "responseTooManyFailedAttempts"
+	CodexErrorCodeActiveTurnNotSteerable         CodexErrorCode = "activeTurnNotSteerable"
+)
+
+var allCodexErrorCodes = []CodexErrorCode{
+	CodexErrorCodeContextWindowExceeded,
+	CodexErrorCodeUsageLimitExceeded,
+	CodexErrorCodeServerOverloaded,
+	CodexErrorCodeCyberPolicy,
+	CodexErrorCodeInternalServerError,
+	CodexErrorCodeUnauthorized,
+	CodexErrorCodeBadRequest,
+	CodexErrorCodeThreadRollbackFailed,
+	CodexErrorCodeSandboxError,
+	CodexErrorCodeOther,
+	CodexErrorCodeHTTPConnectionFailed,
+	CodexErrorCodeResponseStreamConnectionFailed,
+	CodexErrorCodeResponseStreamDisconnected,
+	CodexErrorCodeResponseTooManyFailedAttempts,
+	CodexErrorCodeActiveTurnNotSteerable,
+}
+
+var validCodexErrorCodes = newEnumSet(allCodexErrorCodes...)
+
+// CodexErrorCodeValues returns every known CodexErrorCode value in protocol order.
+func CodexErrorCodeValues() []CodexErrorCode {
+	return slices.Clone(allCodexErrorCodes)
+}
+
+// Valid reports whether c is a known CodexErrorCode value.
+func (c CodexErrorCode) Valid() bool {
+	_, ok := validCodexErrorCodes[c]
+	return ok
+}
+
+// NonSteerableTurnKind identifies an active turn that cannot accept steering.
+type NonSteerableTurnKind string
+
+const (
+	NonSteerableTurnKindReview  NonSteerableTurnKind = "review"
+	NonSteerableTurnKindCompact NonSteerableTurnKind = "compact"
+)
+
+var allNonSteerableTurnKinds = []NonSteerableTurnKind{
+	NonSteerableTurnKindReview,
+	NonSteerableTurnKindCompact,
+}
+
+var validNonSteerableTurnKinds = newEnumSet(allNonSteerableTurnKinds...)
+
+// NonSteerableTurnKindValues returns every known NonSteerableTurnKind value in protocol order.
+func NonSteerableTurnKindValues() []NonSteerableTurnKind {
+	return slices.Clone(allNonSteerableTurnKinds)
+}
+
+// Valid reports whether k is a known NonSteerableTurnKind value.
+func (k NonSteerableTurnKind) Valid() bool {
+	_, ok := validNonSteerableTurnKinds[k]
+	return ok
+}
+
+func (k NonSteerableTurnKind) MarshalJSON() ([]byte, error) {
+	return marshalEnumString("turnKind", k, validNonSteerableTurnKinds)
+}
+
+func (k *NonSteerableTurnKind) UnmarshalJSON(data []byte) error {
+	return unmarshalEnumString(data, "turnKind", validNonSteerableTurnKinds, k)
+}
@@ -3,6 +3,7 @@ package codex
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
 )
 
 // Shared Event Types
@@ -52,9 +53,22 @@ const (
 	MessagePhaseFinalAnswer MessagePhase = "final_answer" // Terminal answer text for the current turn
 )
 
-var validMessagePhases = map[MessagePhase]struct{}{
-	MessagePhaseCommentary:  {},
-	MessagePhaseFinalAnswer: {},
+var allMessagePhases = []MessagePhase{
+	MessagePhaseCommentary,
+	MessagePhaseFinalAnswer,
+}
+
+var validMessagePhases = newEnumSet(allMessagePhases...)
+
+// MessagePhaseValues returns every known MessagePhase value in protocol order.
+func MessagePhaseValues() []MessagePhase {
+	return slices.Clone(allMessagePhases)
+}
+
+// Valid reports whether p is a known MessagePhase value.
+func (p MessagePhase) Valid() bool {
+	_, ok := validMessagePhases[p]
+	return ok
 }
 
 func (p *MessagePhase) UnmarshalJSON(data []byte) error {
@@ -71,11 +85,24 @@ const (
 	CommandExecutionStatusDeclined   CommandExecutionStatus = "declined"
 )
 
-var validCommandExecutionStatuses = map[CommandExecutionStatus]struct{}{
-	CommandExecutionStatusInProgress: {},
-	CommandExecutionStatusCompleted:  {},
-	CommandExecutionStatusFailed:     {},
-	CommandExecutionStatusDeclined:   {},
+var allCommandExecutionStatuses = []CommandExecutionStatus{
+	CommandExecutionStatusInProgress,
+	CommandExecutionStatusCompleted,
+	CommandExecutionStatusFailed,
+	CommandExecutionStatusDeclined,
+}
+
+var validCommandExecutionStatuses = newEnumSet(allCommandExecutionStatuses...)
+
+// CommandExecutionStatusValues returns every known CommandExecutionStatus value in protocol order.
+func CommandExecutionStatusValues() []CommandExecutionStatus {
+	return slices.Clone(allCommandExecutionStatuses)
+}
+
+// Valid reports whether s is a known CommandExecutionStatus value.
+func (s CommandExecutionStatus) Valid() bool {
+	_, ok := validCommandExecutionStatuses[s]
+	return ok
 }
 
 func (s *CommandExecutionStatus) UnmarshalJSON(data []byte) error {
@@ -92,11 +119,24 @@ const (
 	PatchApplyStatusDeclined   PatchApplyStatus = "declined"
 )
 
-var validPatchApplyStatuses = map[PatchApplyStatus]struct{}{
-	PatchApplyStatusInProgress: {},
-	PatchApplyStatusCompleted:  {},
-	PatchApplyStatusFailed:     {},
-	PatchApplyStatusDeclined:   {},
+var allPatchApplyStatuses = []PatchApplyStatus{
+	PatchApplyStatusInProgress,
+	PatchApplyStatusCompleted,
+	PatchApplyStatusFailed,
+	PatchApplyStatusDeclined,
+}
+
+var validPatchApplyStatuses = newEnumSet(allPatchApplyStatuses...)
+
+// PatchApplyStatusValues returns every known PatchApplyStatus value in protocol order.
+func PatchApplyStatusValues() []PatchApplyStatus {
+	return slices.Clone(allPatchApplyStatuses)
+}
+
+// Valid reports whether s is a known PatchApplyStatus value.
+func (s PatchApplyStatus) Valid() bool {
+	_, ok := validPatchApplyStatuses[s]
+	return ok
 }
 
 func (s *PatchApplyStatus) UnmarshalJSON(data []byte) error {
@@ -112,10 +152,23 @@ const (
 	McpToolCallStatusFailed     McpToolCallStatus = "failed"
 )
 
-var validMcpToolCallStatuses = map[McpToolCallStatus]struct{}{
-	McpToolCallStatusInProgress: {},
-	McpToolCallStatusCompleted:  {},
-	McpToolCallStatusFailed:     {},
+var allMcpToolCallStatuses = []McpToolCallStatus{
+	McpToolCallStatusInProgress,
+	McpToolCallStatusCompleted,
+	McpToolCallStatusFailed,
+}
+
+var validMcpToolCallStatuses = newEnumSet(allMcpToolCallStatuses...)
+
+// McpToolCallStatusValues returns every known McpToolCallStatus value in protocol order.
+func McpToolCallStatusValues() []McpToolCallStatus {
+	return slices.Clone(allMcpToolCallStatuses)
+}
+
+// Valid reports whether s is a known McpToolCallStatus value.
+func (s McpToolCallStatus) Valid() bool {
+	_, ok := validMcpToolCallStatuses[s]
+	return ok
 }
 
 func (s *McpToolCallStatus) UnmarshalJSON(data []byte) error {
@@ -131,10 +184,23 @@ const (
 	DynamicToolCallStatusFailed     DynamicToolCallStatus = "failed"
 )
 
-var validDynamicToolCallStatuses = map[DynamicToolCallStatus]struct{}{
-	DynamicToolCallStatusInProgress: {},
-	DynamicToolCallStatusCompleted:  {},
-	DynamicToolCallStatusFailed:     {},
+var allDynamicToolCallStatuses = []DynamicToolCallStatus{
+	DynamicToolCallStatusInProgress,
+	DynamicToolCallStatusCompleted,
+	DynamicToolCallStatusFailed,
+}
+
+var validDynamicToolCallStatuses = newEnumSet(allDynamicToolCallStatuses...)
+
+// DynamicToolCallStatusValues returns every known DynamicToolCallStatus value in protocol order.
+func DynamicToolCallStatusValues() []DynamicToolCallStatus {
+	return slices.Clone(allDynamicToolCallStatuses)
+}
+
+// Valid reports whether s is a known DynamicToolCallStatus value.
+func (s DynamicToolCallStatus) Valid() bool {
+	_, ok := validDynamicToolCallStatuses[s]
+	return ok
 }
 
 func (s *DynamicToolCallStatus) UnmarshalJSON(data []byte) error {
@@ -154,14 +220,27 @@ const (
 	CollabAgentStatusNotFound    CollabAgentStatus = "notFound"
 )
 
-var validCollabAgentStatuses = map[CollabAgentStatus]struct{}{
-	CollabAgentStatusPendingInit: {},
-	CollabAgentStatusRunning:     {},
-	CollabAgentStatusInterrupted: {},
-	CollabAgentStatusCompleted:   {},
-	CollabAgentStatusErrored:     {},
-	CollabAgentStatusShutdown:    {},
-	CollabAgentStatusNotFound:    {},
+var allCollabAgentStatuses = []CollabAgentStatus{
+	CollabAgentStatusPendingInit,
+	CollabAgentStatusRunning,
+	CollabAgentStatusInterrupted,
+	CollabAgentStatusCompleted,
+	CollabAgentStatusErrored,
+	CollabAgentStatusShutdown,
+	CollabAgentStatusNotFound,
+}
+
+var validCollabAgentStatuses = newEnumSet(allCollabAgentStatuses...)
+
+// CollabAgentStatusValues returns every known CollabAgentStatus value in protocol order.
+func CollabAgentStatusValues() []CollabAgentStatus {
+	return slices.Clone(allCollabAgentStatuses)
+}
+
+// Valid reports whether s is a known CollabAgentStatus value.
+func (s CollabAgentStatus) Valid() bool {
+	_, ok := validCollabAgentStatuses[s]
+	return ok
 }
 
 func (s *CollabAgentStatus) UnmarshalJSON(data []byte) error {
@@ -179,12 +258,25 @@ const (
 	CollabAgentToolCloseAgent  CollabAgentTool = "closeAgent"
 )
 
-var validCollabAgentTools = map[CollabAgentTool]struct{}{
-	CollabAgentToolSpawnAgent:  {},
-	CollabAgentToolSendInput:   {},
-	CollabAgentToolResumeAgent: {},
-	CollabAgentToolWait:        {},
-	CollabAgentToolCloseAgent:  {},
+var allCollabAgentTools = []CollabAgentTool{
+	CollabAgentToolSpawnAgent,
+	CollabAgentToolSendInput,
+	CollabAgentToolResumeAgent,
+	CollabAgentToolWait,
+	CollabAgentToolCloseAgent,
+}
+
+var validCollabAgentTools = newEnumSet(allCollabAgentTools...)
+
+// CollabAgentToolValues returns every known CollabAgentTool value in protocol order.
+func CollabAgentToolValues() []CollabAgentTool {
+	return slices.Clone(allCollabAgentTools)
+}
+
+// Valid reports whether t is a known CollabAgentTool value.
+func (t CollabAgentTool) Valid() bool {
+	_, ok := validCollabAgentTools[t]
+	return ok
 }
 
 func (t *CollabAgentTool) UnmarshalJSON(data []byte) error {
@@ -200,10 +292,23 @@ const (
 	CollabAgentToolCallStatusFailed     CollabAgentToolCallStatus = "failed"
 )
 
-var validCollabAgentToolCallStatuses = map[CollabAgentToolCallStatus]struct{}{
-	CollabAgentToolCallStatusInProgress: {},
-	CollabAgentToolCallStatusCompleted:  {},
-	CollabAgentToolCallStatusFailed:     {},
+var allCollabAgentToolCallStatuses = []CollabAgentToolCallStatus{
+	CollabAgentToolCallStatusInProgress,
+	CollabAgentToolCallStatusCompleted,
+	CollabAgentToolCallStatusFailed,
+}
+
+var validCollabAgentToolCallStatuses = newEnumSet(allCollabAgentToolCallStatuses...)
+
+// CollabAgentToolCallStatusValues returns every known CollabAgentToolCallStatus value in protocol order.
+func CollabAgentToolCallStatusValues() []CollabAgentToolCallStatus {
+	return slices.Clone(allCollabAgentToolCallStatuses)
+}
+
+// Valid reports whether s is a known CollabAgentToolCallStatus value.
+func (s CollabAgentToolCallStatus) Valid() bool {
+	_, ok := validCollabAgentToolCallStatuses[s]
+	return ok
 }
 
 func (s *CollabAgentToolCallStatus) UnmarshalJSON(data []byte) error {
@@ -250,6 +355,26 @@ const (
 	CommandExecutionSourceUnifiedExecInteraction CommandExecutionSource = "unifiedExecInteraction"
 )
 
+var allCommandExecutionSources = []CommandExecutionSource{
+	CommandExecutionSourceAgent,
+	CommandExecutionSourceUserShell,
+	CommandExecutionSourceUnifiedExecStartup,
+	CommandExecutionSourceUnifiedExecInteraction,
+}
+
+var validCommandExecutionSources = newEnumSet(allCommandExecutionSources...)
+
+// CommandExecutionSourceValues returns every known CommandExecutionSource value in protocol order.
+func CommandExecutionSourceValues() []CommandExecutionSource {
+	return slices.Clone(allCommandExecutionSources)
+}
+
+// Valid reports whether c is a known CommandExecutionSource value.
+func (c CommandExecutionSource) Valid() bool {
+	_, ok := validCommandExecutionSources[c]
+	return ok
+}
+
 // FileUpdateChange represents a file change with diff and kind.
 type FileUpdateChange struct {
 	Path string                 `json:"path"`
@@ -3,6 +3,7 @@ package codex
 import (
 	"context"
 	"encoding/json"
+	"slices"
 )
 
 // ExperimentalFeatureStage represents the lifecycle stage of an experimental feature flag
@@ -16,12 +17,25 @@ const (
 	ExperimentalFeatureStageRemoved          ExperimentalFeatureStage = "removed"
 )
 
-var validExperimentalFeatureStages = map[ExperimentalFeatureStage]struct{}{
-	ExperimentalFeatureStageBeta:             {},
-	ExperimentalFeatureStageUnderDevelopment: {},
-	ExperimentalFeatureStageStable:           {},
-	ExperimentalFeatureStageDeprecated:       {},
-	ExperimentalFeatureStageRemoved:          {},
+var allExperimentalFeatureStages = []ExperimentalFeatureStage{
+	ExperimentalFeatureStageBeta,
+	ExperimentalFeatureStageUnderDevelopment,
+	ExperimentalFeatureStageStable,
+	ExperimentalFeatureStageDeprecated,
+	ExperimentalFeatureStageRemoved,
+}
+
+var validExperimentalFeatureStages = newEnumSet(allExperimentalFeatureStages...)
+
+// ExperimentalFeatureStageValues returns every known ExperimentalFeatureStage value in protocol order.
+func ExperimentalFeatureStageValues() []ExperimentalFeatureStage {
+	return slices.Clone(allExperimentalFeatureStages)
+}
+
+// Valid reports whether s is a known ExperimentalFeatureStage value.
+func (s ExperimentalFeatureStage) Valid() bool {
+	_, ok := validExperimentalFeatureStages[s]
+	return ok
 }
 
 func (s *ExperimentalFeatureStage) UnmarshalJSON(data []byte) error {
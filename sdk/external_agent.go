@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 )
 
 // ExternalAgentConfigMigrationItemType represents the type of external agent config migration item.
@@ -21,16 +22,29 @@ const (
 	MigrationItemTypeSessions        ExternalAgentConfigMigrationItemType = "SESSIONS"
 )
 
-var validExternalAgentConfigMigrationItemTypes = map[ExternalAgentConfigMigrationItemType]struct{}{
-	MigrationItemTypeAgentsMd:        {},
-	MigrationItemTypeConfig:          {},
-	MigrationItemTypeSkills:          {},
-	MigrationItemTypeMcpServerConfig: {},
-	MigrationItemTypePlugins:         {},
-	MigrationItemTypeSubagents:       {},
-	MigrationItemTypeHooks:           {},
-	MigrationItemTypeCommands:        {},
-	MigrationItemTypeSessions:        {},
+var allExternalAgentConfigMigrationItemTypes = []ExternalAgentConfigMigrationItemType{
+	MigrationItemTypeAgentsMd,
+	MigrationItemTypeConfig,
+	MigrationItemTypeSkills,
+	MigrationItemTypeMcpServerConfig,
+	MigrationItemTypePlugins,
+	MigrationItemTypeSubagents,
+	MigrationItemTypeHooks,
+	MigrationItemTypeCommands,
+	MigrationItemTypeSessions,
+}
+
+var validExternalAgentConfigMigrationItemTypes = newEnumSet(allExternalAgentConfigMigrationItemTypes...)
+
+// ExternalAgentConfigMigrationItemTypeValues returns every known ExternalAgentConfigMigrationItemType value in protocol order.
+func ExternalAgentConfigMigrationItemTypeValues() []ExternalAgentConfigMigrationItemType {
+	return slices.Clone(allExternalAgentConfigMigrationItemTypes)
+}
+
+// Valid reports whether t is a known ExternalAgentConfigMigrationItemType value.
+func (t ExternalAgentConfigMigrationItemType) Valid() bool {
+	_, ok := validExternalAgentConfigMigrationItemTypes[t]
+	return ok
 }
 
 func (t *ExternalAgentConfigMigrationItemType) UnmarshalJSON(data []byte) error {
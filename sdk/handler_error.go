@@ -0,0 +1,100 @@
+package codex
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// HandlerError describes a panic recovered from a notification handler. It is
+// the error passed to the WithHandlerErrorCallback callback in that case; use
+// errors.As to reach the diagnostic fields.
+type HandlerError struct {
+	// Method is the JSON-RPC method of the notification being dispatched.
+	Method string
+	// Params is a copy of the notification params with credential-like values
+	// (tokens, secrets, passwords, API keys) replaced by "[REDACTED]". It is nil
+	// when the params were absent or not valid JSON.
+	Params json.RawMessage
+	// Recovered is the value passed to panic.
+	Recovered any
+	// Stack is the goroutine stack captured when the panic was recovered.
+	Stack []byte
+}
+
+func (e *HandlerError) Error() string {
+	return fmt.Sprintf("notification handler for %s panicked: %v", e.Method, e.Recovered)
+}
+
+// Unwrap returns the recovered value when the handler panicked with an error.
+func (e *HandlerError) Unwrap() error {
+	if err, ok := e.Recovered.(error); ok {
+		return err
+	}
+	return nil
+}
+
+const redactedValue = "[REDACTED]"
+
+var sensitiveParamKeyFragments = []string{
+	"secret",
+	"password",
+	"apikey",
+	"authorization",
+	"cookie",
+	"credential",
+}
+
+// redactParams returns a copy of params with the values of credential-like
+// object keys replaced at any depth.
+func redactParams(params json.RawMessage) json.RawMessage {
+	if len(bytes.TrimSpace(params)) == 0 {
+		return nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(params))
+	dec.UseNumber()
+	var value any
+	if err := dec.Decode(&value); err != nil {
+		return nil
+	}
+	redacted, err := json.Marshal(redactValue(value))
+	if err != nil {
+		return nil
+	}
+	return redacted
+}
+
+func redactValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, child := range v {
+			if isSensitiveParamKey(key) {
+				v[key] = redactedValue
+				continue
+			}
+			v[key] = redactValue(child)
+		}
+	case []any:
+		for i, child := range v {
+			v[i] = redactValue(child)
+		}
+	}
+	return value
+}
+
+// isSensitiveParamKey matches keys case-insensitively, ignoring '_' and '-'.
+// Keys ending in "token" (accessToken, refresh_token) are sensitive, but token
+// accounting keys such as tokenUsage and inputTokens are not.
+func isSensitiveParamKey(key string) bool {
+	lower := strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(key))
+	if strings.HasSuffix(lower, "token") {
+		return true
+	}
+	for _, fragment := range sensitiveParamKeyFragments {
+		if strings.Contains(lower, fragment) {
+			return true
+		}
+	}
+	return false
+}
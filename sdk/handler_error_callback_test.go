@@ -271,3 +271,50 @@ func TestHandlerErrorCallback_Concurrent(t *testing.T) {
 		t.Errorf("expected %d callbacks, got %d", goroutines, callbackCount.Load())
 	}
 }
+
+func TestHandlerErrorCallback_NotificationPanicCarriesDiagnostics(t *testing.T) {
+	var gotErr error
+	mock := NewMockTransport()
+	client := codex.NewClient(mock, codex.WithHandlerErrorCallback(func(_ string, err error) {
+		gotErr = err
+	}))
+
+	cause := errors.New("listener failed")
+	client.OnNotification("test.panic", func(_ context.Context, _ codex.Notification) {
+		panic(cause)
+	})
+
+	mock.InjectServerNotification(context.Background(), codex.Notification{
+		JSONRPC: "2.0",
+		Method:  "test.panic",
+		Params: json.RawMessage(`{"threadId":"t","accessToken":"sk-live","auth":{"api_key":"k","refresh_token":"r"},` +
+			`"tokenUsage":{"inputTokens":3}}`),
+	})
+
+	var handlerErr *codex.HandlerError
+	if !errors.As(gotErr, &handlerErr) {
+		t.Fatalf("handler error = %T %v; want *codex.HandlerError", gotErr, gotErr)
+	}
+	if handlerErr.Method != "test.panic" {
+		t.Errorf("Method = %q; want %q", handlerErr.Method, "test.panic")
+	}
+	if handlerErr.Recovered != cause || !errors.Is(gotErr, cause) {
+		t.Errorf("Recovered = %v; want %v reachable through errors.Is", handlerErr.Recovered, cause)
+	}
+	if !strings.Contains(string(handlerErr.Stack), "TestHandlerErrorCallback_NotificationPanicCarriesDiagnostics") {
+		t.Errorf("Stack does not include the panicking handler:\n%s", handlerErr.Stack)
+	}
+
+	var params map[string]any
+	if err := json.Unmarshal(handlerErr.Params, &params); err != nil {
+		t.Fatalf("unmarshal redacted params: %v", err)
+	}
+	auth, _ := params["auth"].(map[string]any)
+	usage, _ := params["tokenUsage"].(map[string]any)
+	if params["accessToken"] != "[REDACTED]" || auth["api_key"] != "[REDACTED]" || auth["refresh_token"] != "[REDACTED]" {
+		t.Errorf("credential values were not redacted: %s", handlerErr.Params)
+	}
+	if params["threadId"] != "t" || usage["inputTokens"] != float64(3) {
+		t.Errorf("non-credential values were altered: %s", handlerErr.Params)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 )
 
 // HookEventName identifies when a hook ran.
@@ -20,15 +21,28 @@ const (
 	HookEventNameStop              HookEventName = "stop"
 )
 
-var validHookEventNames = map[HookEventName]struct{}{
-	HookEventNameSessionStart:      {},
-	HookEventNameUserPromptSubmit:  {},
-	HookEventNamePreToolUse:        {},
-	HookEventNamePermissionRequest: {},
-	HookEventNamePostToolUse:       {},
-	HookEventNamePreCompact:        {},
-	HookEventNamePostCompact:       {},
-	HookEventNameStop:              {},
+var allHookEventNames = []HookEventName{
+	HookEventNameSessionStart,
+	HookEventNameUserPromptSubmit,
+	HookEventNamePreToolUse,
+	HookEventNamePermissionRequest,
+	HookEventNamePostToolUse,
+	HookEventNamePreCompact,
+	HookEventNamePostCompact,
+	HookEventNameStop,
+}
+
+var validHookEventNames = newEnumSet(allHookEventNames...)
+
+// HookEventNameValues returns every known HookEventName value in protocol order.
+func HookEventNameValues() []HookEventName {
+	return slices.Clone(allHookEventNames)
+}
+
+// Valid reports whether n is a known HookEventName value.
+func (n HookEventName) Valid() bool {
+	_, ok := validHookEventNames[n]
+	return ok
 }
 
 func (n HookEventName) MarshalJSON() ([]byte, error) {
@@ -47,9 +61,22 @@ const (
 	HookExecutionModeAsync HookExecutionMode = "async"
 )
 
-var validHookExecutionModes = map[HookExecutionMode]struct{}{
-	HookExecutionModeSync:  {},
-	HookExecutionModeAsync: {},
+var allHookExecutionModes = []HookExecutionMode{
+	HookExecutionModeSync,
+	HookExecutionModeAsync,
+}
+
+var validHookExecutionModes = newEnumSet(allHookExecutionModes...)
+
+// HookExecutionModeValues returns every known HookExecutionMode value in protocol order.
+func HookExecutionModeValues() []HookExecutionMode {
+	return slices.Clone(allHookExecutionModes)
+}
+
+// Valid reports whether m is a known HookExecutionMode value.
+func (m HookExecutionMode) Valid() bool {
+	_, ok := validHookExecutionModes[m]
+	return ok
 }
 
 func (m HookExecutionMode) MarshalJSON() ([]byte, error) {
@@ -69,10 +96,23 @@ const (
 	HookHandlerTypeAgent   HookHandlerType = "agent"
 )
 
-var validHookHandlerTypes = map[HookHandlerType]struct{}{
-	HookHandlerTypeCommand: {},
-	HookHandlerTypePrompt:  {},
-	HookHandlerTypeAgent:   {},
+var allHookHandlerTypes = []HookHandlerType{
+	HookHandlerTypeCommand,
+	HookHandlerTypePrompt,
+	HookHandlerTypeAgent,
+}
+
+var validHookHandlerTypes = newEnumSet(allHookHandlerTypes...)
+
+// HookHandlerTypeValues returns every known HookHandlerType value in protocol order.
+func HookHandlerTypeValues() []HookHandlerType {
+	return slices.Clone(allHookHandlerTypes)
+}
+
+// Valid reports whether t is a known HookHandlerType value.
+func (t HookHandlerType) Valid() bool {
+	_, ok := validHookHandlerTypes[t]
+	return ok
 }
 
 func (t HookHandlerType) MarshalJSON() ([]byte, error) {
@@ -94,12 +134,25 @@ const (
 	HookOutputEntryKindError    HookOutputEntryKind = "error"
 )
 
-var validHookOutputEntryKinds = map[HookOutputEntryKind]struct{}{
-	HookOutputEntryKindWarning:  {},
-	HookOutputEntryKindStop:     {},
-	HookOutputEntryKindFeedback: {},
-	HookOutputEntryKindContext:  {},
-	HookOutputEntryKindError:    {},
+var allHookOutputEntryKinds = []HookOutputEntryKind{
+	HookOutputEntryKindWarning,
+	HookOutputEntryKindStop,
+	HookOutputEntryKindFeedback,
+	HookOutputEntryKindContext,
+	HookOutputEntryKindError,
+}
+
+var validHookOutputEntryKinds = newEnumSet(allHookOutputEntryKinds...)
+
+// HookOutputEntryKindValues returns every known HookOutputEntryKind value in protocol order.
+func HookOutputEntryKindValues() []HookOutputEntryKind {
+	return slices.Clone(allHookOutputEntryKinds)
+}
+
+// Valid reports whether k is a known HookOutputEntryKind value.
+func (k HookOutputEntryKind) Valid() bool {
+	_, ok := validHookOutputEntryKinds[k]
+	return ok
 }
 
 func (k HookOutputEntryKind) MarshalJSON() ([]byte, error) {
@@ -121,12 +174,25 @@ const (
 	HookRunStatusStopped   HookRunStatus = "stopped"
 )
 
-var validHookRunStatuses = map[HookRunStatus]struct{}{
-	HookRunStatusRunning:   {},
-	HookRunStatusCompleted: {},
-	HookRunStatusFailed:    {},
-	HookRunStatusBlocked:   {},
-	HookRunStatusStopped:   {},
+var allHookRunStatuses = []HookRunStatus{
+	HookRunStatusRunning,
+	HookRunStatusCompleted,
+	HookRunStatusFailed,
+	HookRunStatusBlocked,
+	HookRunStatusStopped,
+}
+
+var validHookRunStatuses = newEnumSet(allHookRunStatuses...)
+
+// HookRunStatusValues returns every known HookRunStatus value in protocol order.
+func HookRunStatusValues() []HookRunStatus {
+	return slices.Clone(allHookRunStatuses)
+}
+
+// Valid reports whether s is a known HookRunStatus value.
+func (s HookRunStatus) Valid() bool {
+	_, ok := validHookRunStatuses[s]
+	return ok
 }
 
 func (s HookRunStatus) MarshalJSON() ([]byte, error) {
@@ -145,9 +211,22 @@ const (
 	HookScopeTurn   HookScope = "turn"
 )
 
-var validHookScopes = map[HookScope]struct{}{
-	HookScopeThread: {},
-	HookScopeTurn:   {},
+var allHookScopes = []HookScope{
+	HookScopeThread,
+	HookScopeTurn,
+}
+
+var validHookScopes = newEnumSet(allHookScopes...)
+
+// HookScopeValues returns every known HookScope value in protocol order.
+func HookScopeValues() []HookScope {
+	return slices.Clone(allHookScopes)
+}
+
+// Valid reports whether s is a known HookScope value.
+func (s HookScope) Valid() bool {
+	_, ok := validHookScopes[s]
+	return ok
 }
 
 func (s HookScope) MarshalJSON() ([]byte, error) {
@@ -209,6 +288,32 @@ const (
 	HookSourceUnknown                 HookSource = "unknown"
 )
 
+var allHookSources = []HookSource{
+	HookSourceSystem,
+	HookSourceUser,
+	HookSourceProject,
+	HookSourceMDM,
+	HookSourceSessionFlags,
+	HookSourcePlugin,
+	HookSourceCloudRequirements,
+	HookSourceLegacyManagedConfigFile,
+	HookSourceLegacyManagedConfigMDM,
+	HookSourceUnknown,
+}
+
+var validHookSources = newEnumSet(allHookSources...)
+
+// HookSourceValues returns every known HookSource value in protocol order.
+func HookSourceValues() []HookSource {
+	return slices.Clone(allHookSources)
+}
+
+// Valid reports whether h is a known HookSource value.
+func (h HookSource) Valid() bool {
+	_, ok := validHookSources[h]
+	return ok
+}
+
 func (s *HookRunSummary) UnmarshalJSON(data []byte) error {
 	type wire HookRunSummary
 	var decoded wire
@@ -278,12 +383,25 @@ const (
 	GuardianApprovalReviewStatusTimedOut   GuardianApprovalReviewStatus = "timedOut"
 )
 
-var validGuardianApprovalReviewStatuses = map[GuardianApprovalReviewStatus]struct{}{
-	GuardianApprovalReviewStatusInProgress: {},
-	GuardianApprovalReviewStatusApproved:   {},
-	GuardianApprovalReviewStatusDenied:     {},
-	GuardianApprovalReviewStatusAborted:    {},
-	GuardianApprovalReviewStatusTimedOut:   {},
+var allGuardianApprovalReviewStatuses = []GuardianApprovalReviewStatus{
+	GuardianApprovalReviewStatusInProgress,
+	GuardianApprovalReviewStatusApproved,
+	GuardianApprovalReviewStatusDenied,
+	GuardianApprovalReviewStatusAborted,
+	GuardianApprovalReviewStatusTimedOut,
+}
+
+var validGuardianApprovalReviewStatuses = newEnumSet(allGuardianApprovalReviewStatuses...)
+
+// GuardianApprovalReviewStatusValues returns every known GuardianApprovalReviewStatus value in protocol order.
+func GuardianApprovalReviewStatusValues() []GuardianApprovalReviewStatus {
+	return slices.Clone(allGuardianApprovalReviewStatuses)
+}
+
+// Valid reports whether s is a known GuardianApprovalReviewStatus value.
+func (s GuardianApprovalReviewStatus) Valid() bool {
+	_, ok := validGuardianApprovalReviewStatuses[s]
+	return ok
 }
 
 func (s *GuardianApprovalReviewStatus) UnmarshalJSON(data []byte) error {
@@ -300,11 +418,24 @@ const (
 	GuardianRiskLevelCritical GuardianRiskLevel = "critical"
 )
 
-var validGuardianRiskLevels = map[GuardianRiskLevel]struct{}{
-	GuardianRiskLevelLow:      {},
-	GuardianRiskLevelMedium:   {},
-	GuardianRiskLevelHigh:     {},
-	GuardianRiskLevelCritical: {},
+var allGuardianRiskLevels = []GuardianRiskLevel{
+	GuardianRiskLevelLow,
+	GuardianRiskLevelMedium,
+	GuardianRiskLevelHigh,
+	GuardianRiskLevelCritical,
+}
+
+var validGuardianRiskLevels = newEnumSet(allGuardianRiskLevels...)
+
+// GuardianRiskLevelValues returns every known GuardianRiskLevel value in protocol order.
+func GuardianRiskLevelValues() []GuardianRiskLevel {
+	return slices.Clone(allGuardianRiskLevels)
+}
+
+// Valid reports whether l is a known GuardianRiskLevel value.
+func (l GuardianRiskLevel) Valid() bool {
+	_, ok := validGuardianRiskLevels[l]
+	return ok
 }
 
 func (l *GuardianRiskLevel) UnmarshalJSON(data []byte) error {
@@ -330,6 +461,26 @@ const (
 	GuardianUserAuthorizationHigh    GuardianUserAuthorization = "high"
 )
 
+var allGuardianUserAuthorizations = []GuardianUserAuthorization{
+	GuardianUserAuthorizationUnknown,
+	GuardianUserAuthorizationLow,
+	GuardianUserAuthorizationMedium,
+	GuardianUserAuthorizationHigh,
+}
+
+var validGuardianUserAuthorizations = newEnumSet(allGuardianUserAuthorizations...)
+
+// GuardianUserAuthorizationValues returns every known GuardianUserAuthorization value in protocol order.
+func GuardianUserAuthorizationValues() []GuardianUserAuthorization {
+	return slices.Clone(allGuardianUserAuthorizations)
+}
+
+// Valid reports whether g is a known GuardianUserAuthorization value.
+func (g GuardianUserAuthorization) Valid() bool {
+	_, ok := validGuardianUserAuthorizations[g]
+	return ok
+}
+
 // AutoReviewDecisionSource identifies who made an auto-review decision.
 type AutoReviewDecisionSource string
 
@@ -337,6 +488,23 @@ const (
 	AutoReviewDecisionSourceAgent AutoReviewDecisionSource = "agent"
 )
 
+var allAutoReviewDecisionSources = []AutoReviewDecisionSource{
+	AutoReviewDecisionSourceAgent,
+}
+
+var validAutoReviewDecisionSources = newEnumSet(allAutoReviewDecisionSources...)
+
+// AutoReviewDecisionSourceValues returns every known AutoReviewDecisionSource value in protocol order.
+func AutoReviewDecisionSourceValues() []AutoReviewDecisionSource {
+	return slices.Clone(allAutoReviewDecisionSources)
+}
+
+// Valid reports whether a is a known AutoReviewDecisionSource value.
+func (a AutoReviewDecisionSource) Valid() bool {
+	_, ok := validAutoReviewDecisionSources[a]
+	return ok
+}
+
 func (r *GuardianApprovalReview) UnmarshalJSON(data []byte) error {
 	type wire GuardianApprovalReview
 	var decoded wire
@@ -354,7 +522,7 @@ type ItemGuardianApprovalReviewStartedNotification struct {
 	Review       GuardianApprovalReview `json:"review"`
 	ReviewID     string                 `json:"reviewId"`
 	StartedAtMs  int64                  `json:"startedAtMs"`
-	TargetItemID string                 `json:"targetItemId"`
+	TargetItemID string                 `json:"targetItemId,omitempty"`
 	ThreadID     string                 `json:"threadId"`
 	TurnID       string                 `json:"turnId"`
 }
@@ -378,7 +546,7 @@ type ItemGuardianApprovalReviewCompletedNotification struct {
 	Review         GuardianApprovalReview   `json:"review"`
 	ReviewID       string                   `json:"reviewId"`
 	StartedAtMs    int64                    `json:"startedAtMs"`
-	TargetItemID   string                   `json:"targetItemId"`
+	TargetItemID   string                   `json:"targetItemId,omitempty"`
 	ThreadID       string                   `json:"threadId"`
 	TurnID         string                   `json:"turnId"`
 }
@@ -3,6 +3,7 @@ package codex
 import (
 	"context"
 	"encoding/json"
+	"slices"
 )
 
 // HookTrustStatus is the trust state of a configured hook.
@@ -15,11 +16,24 @@ const (
 	HookTrustStatusModified  HookTrustStatus = "modified"
 )
 
-var validHookTrustStatuses = map[HookTrustStatus]struct{}{
-	HookTrustStatusManaged:   {},
-	HookTrustStatusUntrusted: {},
-	HookTrustStatusTrusted:   {},
-	HookTrustStatusModified:  {},
+var allHookTrustStatuses = []HookTrustStatus{
+	HookTrustStatusManaged,
+	HookTrustStatusUntrusted,
+	HookTrustStatusTrusted,
+	HookTrustStatusModified,
+}
+
+var validHookTrustStatuses = newEnumSet(allHookTrustStatuses...)
+
+// HookTrustStatusValues returns every known HookTrustStatus value in protocol order.
+func HookTrustStatusValues() []HookTrustStatus {
+	return slices.Clone(allHookTrustStatuses)
+}
+
+// Valid reports whether s is a known HookTrustStatus value.
+func (s HookTrustStatus) Valid() bool {
+	_, ok := validHookTrustStatuses[s]
+	return ok
 }
 
 func (s *HookTrustStatus) UnmarshalJSON(data []byte) error {
@@ -84,7 +84,7 @@ type serverNotificationSpec struct {
 	} `json:"oneOf"`
 }
 
-func loadServerNotificationSpec(t *testing.T) serverNotificationSpec {
+func loadServerNotificationSpec(t testing.TB) serverNotificationSpec {
 	t.Helper()
 	data, err := os.ReadFile("../specs/ServerNotification.json")
 	if err != nil {
@@ -97,7 +97,7 @@ func loadServerNotificationSpec(t *testing.T) serverNotificationSpec {
 	return spec
 }
 
-func loadServerNotificationMethodsByType(t *testing.T) map[string]string {
+func loadServerNotificationMethodsByType(t testing.TB) map[string]string {
 	t.Helper()
 	spec := loadServerNotificationSpec(t)
 	methods := make(map[string]string, len(spec.OneOf))
@@ -110,7 +110,7 @@ func loadServerNotificationMethodsByType(t *testing.T) map[string]string {
 	return methods
 }
 
-func sampleServerNotificationParams(t *testing.T, typeName string) json.RawMessage {
+func sampleServerNotificationParams(t testing.TB, typeName string) json.RawMessage {
 	t.Helper()
 	spec := loadServerNotificationSpec(t)
 	value := sampleSchemaValue(t, spec, typeName, map[string]bool{})
@@ -121,7 +121,7 @@ func sampleServerNotificationParams(t *testing.T, typeName string) json.RawMessa
 	return data
 }
 
-func sampleSchemaValue(t *testing.T, spec serverNotificationSpec, typeName string, stack map[string]bool) interface{} {
+func sampleSchemaValue(t testing.TB, spec serverNotificationSpec, typeName string, stack map[string]bool) interface{} {
 	t.Helper()
 	if stack[typeName] {
 		return map[string]interface{}{}
@@ -140,7 +140,7 @@ func sampleSchemaValue(t *testing.T, spec serverNotificationSpec, typeName strin
 	return value
 }
 
-func sampleSchemaNode(t *testing.T, spec serverNotificationSpec, schema interface{}, stack map[string]bool) interface{} {
+func sampleSchemaNode(t testing.TB, spec serverNotificationSpec, schema interface{}, stack map[string]bool) interface{} {
 	t.Helper()
 	node, ok := schema.(map[string]interface{})
 	if !ok {
@@ -192,7 +192,7 @@ func sampleSchemaNode(t *testing.T, spec serverNotificationSpec, schema interfac
 	}
 }
 
-func sampleFirstNonNullVariant(t *testing.T, spec serverNotificationSpec, variants []interface{}, stack map[string]bool) interface{} {
+func sampleFirstNonNullVariant(t testing.TB, spec serverNotificationSpec, variants []interface{}, stack map[string]bool) interface{} {
 	t.Helper()
 	for _, variant := range variants {
 		if node, ok := variant.(map[string]interface{}); ok && schemaType(node["type"]) == "null" {
@@ -203,7 +203,7 @@ func sampleFirstNonNullVariant(t *testing.T, spec serverNotificationSpec, varian
 	return nil
 }
 
-func sampleObjectNode(t *testing.T, spec serverNotificationSpec, node map[string]interface{}, stack map[string]bool) map[string]interface{} {
+func sampleObjectNode(t testing.TB, spec serverNotificationSpec, node map[string]interface{}, stack map[string]bool) map[string]interface{} {
 	t.Helper()
 	result := make(map[string]interface{})
 	properties, _ := node["properties"].(map[string]interface{})
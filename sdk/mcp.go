@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 )
 
 // McpAuthStatus represents the authentication status of an MCP server.
@@ -16,11 +17,24 @@ const (
 	McpAuthStatusOAuth       McpAuthStatus = "oAuth"
 )
 
-var validMcpAuthStatuses = map[McpAuthStatus]struct{}{
-	McpAuthStatusUnsupported: {},
-	McpAuthStatusNotLoggedIn: {},
-	McpAuthStatusBearerToken: {},
-	McpAuthStatusOAuth:       {},
+var allMcpAuthStatuses = []McpAuthStatus{
+	McpAuthStatusUnsupported,
+	McpAuthStatusNotLoggedIn,
+	McpAuthStatusBearerToken,
+	McpAuthStatusOAuth,
+}
+
+var validMcpAuthStatuses = newEnumSet(allMcpAuthStatuses...)
+
+// McpAuthStatusValues returns every known McpAuthStatus value in protocol order.
+func McpAuthStatusValues() []McpAuthStatus {
+	return slices.Clone(allMcpAuthStatuses)
+}
+
+// Valid reports whether s is a known McpAuthStatus value.
+func (s McpAuthStatus) Valid() bool {
+	_, ok := validMcpAuthStatuses[s]
+	return ok
 }
 
 func (s *McpAuthStatus) UnmarshalJSON(data []byte) error {
@@ -35,9 +49,22 @@ const (
 	McpServerStatusDetailToolsAndAuthOnly McpServerStatusDetail = "toolsAndAuthOnly"
 )
 
-var validMcpServerStatusDetails = map[McpServerStatusDetail]struct{}{
-	McpServerStatusDetailFull:             {},
-	McpServerStatusDetailToolsAndAuthOnly: {},
+var allMcpServerStatusDetails = []McpServerStatusDetail{
+	McpServerStatusDetailFull,
+	McpServerStatusDetailToolsAndAuthOnly,
+}
+
+var validMcpServerStatusDetails = newEnumSet(allMcpServerStatusDetails...)
+
+// McpServerStatusDetailValues returns every known McpServerStatusDetail value in protocol order.
+func McpServerStatusDetailValues() []McpServerStatusDetail {
+	return slices.Clone(allMcpServerStatusDetails)
+}
+
+// Valid reports whether m is a known McpServerStatusDetail value.
+func (m McpServerStatusDetail) Valid() bool {
+	_, ok := validMcpServerStatusDetails[m]
+	return ok
 }
 
 func validateOptionalMcpServerStatusDetailField(field string, value *McpServerStatusDetail) error {
@@ -275,11 +302,24 @@ const (
 	McpServerStartupStateCancelled McpServerStartupState = "cancelled"
 )
 
-var validMcpServerStartupStates = map[McpServerStartupState]struct{}{
-	McpServerStartupStateStarting:  {},
-	McpServerStartupStateReady:     {},
-	McpServerStartupStateFailed:    {},
-	McpServerStartupStateCancelled: {},
+var allMcpServerStartupStates = []McpServerStartupState{
+	McpServerStartupStateStarting,
+	McpServerStartupStateReady,
+	McpServerStartupStateFailed,
+	McpServerStartupStateCancelled,
+}
+
+var validMcpServerStartupStates = newEnumSet(allMcpServerStartupStates...)
+
+// McpServerStartupStateValues returns every known McpServerStartupState value in protocol order.
+func McpServerStartupStateValues() []McpServerStartupState {
+	return slices.Clone(allMcpServerStartupStates)
+}
+
+// Valid reports whether s is a known McpServerStartupState value.
+func (s McpServerStartupState) Valid() bool {
+	_, ok := validMcpServerStartupStates[s]
+	return ok
 }
 
 func (s *McpServerStartupState) UnmarshalJSON(data []byte) error {
@@ -0,0 +1,90 @@
+package codex
+
+import (
+	"math"
+	"slices"
+	"time"
+)
+
+// methodStatSamples is how many recent latencies are kept per method for
+// percentile estimates.
+const methodStatSamples = 256
+
+// MethodStat summarizes request latency for one JSON-RPC method, measured
+// around the transport round trip in Client.Send.
+type MethodStat struct {
+	// Count is the number of requests sent, including failed ones.
+	Count uint64
+	// Errors is how many of those requests failed, either in the transport or
+	// with a JSON-RPC error response.
+	Errors uint64
+	// P50 and P95 are nearest-rank percentiles over the most recent 256
+	// requests.
+	P50 time.Duration
+	P95 time.Duration
+	// Max is the slowest request observed since the client was created.
+	Max time.Duration
+}
+
+type methodLatencies struct {
+	count   uint64
+	errors  uint64
+	max     time.Duration
+	samples []time.Duration // ring buffer of the latest methodStatSamples latencies
+	next    int
+}
+
+// MethodStats returns request latency statistics keyed by JSON-RPC method for
+// every method this client has sent. The map is a snapshot and safe to modify.
+func (c *Client) MethodStats() map[string]MethodStat {
+	c.methodStatsMu.Lock()
+	defer c.methodStatsMu.Unlock()
+
+	stats := make(map[string]MethodStat, len(c.methodStats))
+	for method, latencies := range c.methodStats {
+		sorted := slices.Clone(latencies.samples)
+		slices.Sort(sorted)
+		stats[method] = MethodStat{
+			Count:  latencies.count,
+			Errors: latencies.errors,
+			P50:    nearestRank(sorted, 0.50),
+			P95:    nearestRank(sorted, 0.95),
+			Max:    latencies.max,
+		}
+	}
+	return stats
+}
+
+func (c *Client) recordMethodLatency(method string, latency time.Duration, failed bool) {
+	c.methodStatsMu.Lock()
+	defer c.methodStatsMu.Unlock()
+
+	if c.methodStats == nil {
+		c.methodStats = make(map[string]*methodLatencies)
+	}
+	latencies, ok := c.methodStats[method]
+	if !ok {
+		latencies = &methodLatencies{}
+		c.methodStats[method] = latencies
+	}
+
+	latencies.count++
+	if failed {
+		latencies.errors++
+	}
+	latencies.max = max(latencies.max, latency)
+	if len(latencies.samples) < methodStatSamples {
+		latencies.samples = append(latencies.samples, latency)
+		return
+	}
+	latencies.samples[latencies.next] = latency
+	latencies.next = (latencies.next + 1) % methodStatSamples
+}
+
+func nearestRank(sorted []time.Duration, percentile float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(percentile * float64(len(sorted))))
+	return sorted[max(rank, 1)-1]
+}
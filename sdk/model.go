@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 )
 
 // ModelListParams are parameters for listing available models.
@@ -184,8 +185,21 @@ const (
 	ModelRerouteReasonHighRiskCyberActivity ModelRerouteReason = "highRiskCyberActivity"
 )
 
-var validModelRerouteReasons = map[ModelRerouteReason]struct{}{
-	ModelRerouteReasonHighRiskCyberActivity: {},
+var allModelRerouteReasons = []ModelRerouteReason{
+	ModelRerouteReasonHighRiskCyberActivity,
+}
+
+var validModelRerouteReasons = newEnumSet(allModelRerouteReasons...)
+
+// ModelRerouteReasonValues returns every known ModelRerouteReason value in protocol order.
+func ModelRerouteReasonValues() []ModelRerouteReason {
+	return slices.Clone(allModelRerouteReasons)
+}
+
+// Valid reports whether r is a known ModelRerouteReason value.
+func (r ModelRerouteReason) Valid() bool {
+	_, ok := validModelRerouteReasons[r]
+	return ok
 }
 
 func (r *ModelRerouteReason) UnmarshalJSON(data []byte) error {
@@ -199,6 +213,23 @@ const (
 	ModelVerificationTrustedAccessForCyber ModelVerification = "trustedAccessForCyber"
 )
 
+var allModelVerifications = []ModelVerification{
+	ModelVerificationTrustedAccessForCyber,
+}
+
+var validModelVerifications = newEnumSet(allModelVerifications...)
+
+// ModelVerificationValues returns every known ModelVerification value in protocol order.
+func ModelVerificationValues() []ModelVerification {
+	return slices.Clone(allModelVerifications)
+}
+
+// Valid reports whether m is a known ModelVerification value.
+func (m ModelVerification) Valid() bool {
+	_, ok := validModelVerifications[m]
+	return ok
+}
+
 // ModelVerificationNotification is sent when model verifications are available.
 type ModelVerificationNotification struct {
 	ThreadID      string              `json:"threadId"`
@@ -3,6 +3,9 @@ package codex_test
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -725,3 +728,64 @@ func mustJSONRawMessage(t *testing.T, value interface{}) json.RawMessage {
 	}
 	return data
 }
+
+func TestWithPathValidationChecksSandboxRootsExist(t *testing.T) {
+	existing := t.TempDir()
+	file := filepath.Join(existing, "notes.txt")
+	if err := os.WriteFile(file, nil, 0o600); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+	missing := filepath.Join(existing, "missing")
+
+	startTurn := func(client *codex.Client, policy codex.SandboxPolicyWrapper) error {
+		sandbox := policy.Value
+		_, err := client.Turn.Start(context.Background(), codex.TurnStartParams{
+			ThreadID:      "thread-1",
+			Input:         []codex.UserInput{&codex.TextUserInput{Text: "hi"}},
+			SandboxPolicy: &sandbox,
+		})
+		return err
+	}
+	readOnlyWithRoot := func(root string) codex.SandboxPolicyWrapper {
+		return codex.SandboxPolicyWrapper{Value: codex.SandboxPolicyReadOnly{
+			Access: &codex.ReadOnlyAccessWrapper{Value: codex.ReadOnlyAccessRestricted{ReadableRoots: []string{root}}},
+		}}
+	}
+
+	tests := []struct {
+		name      string
+		opts      []codex.ClientOption
+		policy    codex.SandboxPolicyWrapper
+		wantError string
+	}{
+		{name: "existing writable root", opts: []codex.ClientOption{codex.WithPathValidation()}, policy: codex.RepoSandbox(existing)},
+		{name: "missing writable root", opts: []codex.ClientOption{codex.WithPathValidation()}, policy: codex.RepoSandbox(missing), wantError: "sandboxPolicy.writableRoots[0]"},
+		{name: "file as writable root", opts: []codex.ClientOption{codex.WithPathValidation()}, policy: codex.RepoSandbox(file), wantError: "is not a directory"},
+		{name: "missing readable root", opts: []codex.ClientOption{codex.WithPathValidation()}, policy: readOnlyWithRoot(missing), wantError: "sandboxPolicy.access.readableRoots[0]"},
+		{name: "missing root without validation", policy: codex.RepoSandbox(missing)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transport := NewMockTransport()
+			_ = transport.SetResponseData("turn/start", map[string]interface{}{
+				"turn": map[string]interface{}{"id": "turn-1", "status": "inProgress", "items": []interface{}{}},
+			})
+			client := codex.NewClient(transport, tt.opts...)
+
+			err := startTurn(client, tt.policy)
+			if tt.wantError == "" {
+				if err != nil {
+					t.Fatalf("Turn.Start() error = %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, codex.ErrInvalidParams) || !strings.Contains(err.Error(), tt.wantError) {
+				t.Fatalf("Turn.Start() error = %v; want ErrInvalidParams mentioning %q", err, tt.wantError)
+			}
+			if transport.CallCount() != 0 {
+				t.Fatal("request with a missing sandbox root was sent")
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package codex
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+)
+
+// WithPathValidation makes turn/start and command/exec check, before the
+// request is sent, that every writable and readable root in its sandbox
+// policy exists on the local filesystem and is a directory. A missing root
+// fails the request with an error wrapping ErrInvalidParams that names the
+// offending field.
+//
+// Roots must be absolute paths whether or not this option is set; that check
+// never touches the filesystem. Enable this option only when the server runs
+// on the same machine as the client, since the roots are resolved locally.
+func WithPathValidation() ClientOption {
+	return func(c *Client) {
+		c.pathValidation = true
+	}
+}
+
+// validateSandboxRootsExist stats the sandbox roots of already prepared
+// request params. Params without a sandbox policy pass unchecked.
+func validateSandboxRootsExist(params interface{}) error {
+	var policy SandboxPolicy
+	switch p := params.(type) {
+	case TurnStartParams:
+		if p.SandboxPolicy != nil {
+			policy = *p.SandboxPolicy
+		}
+	case CommandExecParams:
+		if p.SandboxPolicy != nil {
+			policy = p.SandboxPolicy.Value
+		}
+	}
+
+	for _, root := range sandboxPolicyRoots("sandboxPolicy", policy) {
+		info, err := os.Stat(root.path)
+		switch {
+		case errors.Is(err, fs.ErrNotExist):
+			return invalidParamsError("%s: %s does not exist", root.field, root.path)
+		case err != nil:
+			return invalidParamsError("%s: %v", root.field, err)
+		case !info.IsDir():
+			return invalidParamsError("%s: %s is not a directory", root.field, root.path)
+		}
+	}
+	return nil
+}
+
+type sandboxRoot struct {
+	field string
+	path  string
+}
+
+func sandboxPolicyRoots(field string, policy SandboxPolicy) []sandboxRoot {
+	var roots []sandboxRoot
+	switch v := normalizeSandboxPolicy(policy).(type) {
+	case SandboxPolicyReadOnly:
+		roots = readOnlyAccessRoots(field+".access", v.Access)
+	case SandboxPolicyWorkspaceWrite:
+		for i, path := range v.WritableRoots {
+			roots = append(roots, sandboxRoot{field: fmt.Sprintf("%s.writableRoots[%d]", field, i), path: path})
+		}
+		roots = append(roots, readOnlyAccessRoots(field+".readOnlyAccess", v.ReadOnlyAccess)...)
+	}
+	return roots
+}
+
+func readOnlyAccessRoots(field string, access *ReadOnlyAccessWrapper) []sandboxRoot {
+	if access == nil {
+		return nil
+	}
+	restricted, ok := normalizeReadOnlyAccess(access.Value).(ReadOnlyAccessRestricted)
+	if !ok {
+		return nil
+	}
+	roots := make([]sandboxRoot, len(restricted.ReadableRoots))
+	for i, path := range restricted.ReadableRoots {
+		roots[i] = sandboxRoot{field: fmt.Sprintf("%s.readableRoots[%d]", field, i), path: path}
+	}
+	return roots
+}
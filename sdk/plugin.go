@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"slices"
 )
 
 // PluginAuthPolicy controls when plugin auth is requested.
@@ -14,9 +15,22 @@ const (
 	PluginAuthPolicyOnUse     PluginAuthPolicy = "ON_USE"
 )
 
-var validPluginAuthPolicies = map[PluginAuthPolicy]struct{}{
-	PluginAuthPolicyOnInstall: {},
-	PluginAuthPolicyOnUse:     {},
+var allPluginAuthPolicies = []PluginAuthPolicy{
+	PluginAuthPolicyOnInstall,
+	PluginAuthPolicyOnUse,
+}
+
+var validPluginAuthPolicies = newEnumSet(allPluginAuthPolicies...)
+
+// PluginAuthPolicyValues returns every known PluginAuthPolicy value in protocol order.
+func PluginAuthPolicyValues() []PluginAuthPolicy {
+	return slices.Clone(allPluginAuthPolicies)
+}
+
+// Valid reports whether p is a known PluginAuthPolicy value.
+func (p PluginAuthPolicy) Valid() bool {
+	_, ok := validPluginAuthPolicies[p]
+	return ok
 }
 
 func validatePluginAuthPolicyField(field string, value PluginAuthPolicy) error {
@@ -32,10 +46,23 @@ const (
 	PluginInstallPolicyInstalledByDefault PluginInstallPolicy = "INSTALLED_BY_DEFAULT"
 )
 
-var validPluginInstallPolicies = map[PluginInstallPolicy]struct{}{
-	PluginInstallPolicyNotAvailable:       {},
-	PluginInstallPolicyAvailable:          {},
-	PluginInstallPolicyInstalledByDefault: {},
+var allPluginInstallPolicies = []PluginInstallPolicy{
+	PluginInstallPolicyNotAvailable,
+	PluginInstallPolicyAvailable,
+	PluginInstallPolicyInstalledByDefault,
+}
+
+var validPluginInstallPolicies = newEnumSet(allPluginInstallPolicies...)
+
+// PluginInstallPolicyValues returns every known PluginInstallPolicy value in protocol order.
+func PluginInstallPolicyValues() []PluginInstallPolicy {
+	return slices.Clone(allPluginInstallPolicies)
+}
+
+// Valid reports whether p is a known PluginInstallPolicy value.
+func (p PluginInstallPolicy) Valid() bool {
+	_, ok := validPluginInstallPolicies[p]
+	return ok
 }
 
 func validatePluginInstallPolicyField(field string, value PluginInstallPolicy) error {
@@ -50,9 +77,22 @@ const (
 	PluginAvailabilityAvailable       PluginAvailability = "AVAILABLE"
 )
 
-var validPluginAvailabilities = map[PluginAvailability]struct{}{
-	PluginAvailabilityDisabledByAdmin: {},
-	PluginAvailabilityAvailable:       {},
+var allPluginAvailabilities = []PluginAvailability{
+	PluginAvailabilityDisabledByAdmin,
+	PluginAvailabilityAvailable,
+}
+
+var validPluginAvailabilities = newEnumSet(allPluginAvailabilities...)
+
+// PluginAvailabilityValues returns every known PluginAvailability value in protocol order.
+func PluginAvailabilityValues() []PluginAvailability {
+	return slices.Clone(allPluginAvailabilities)
+}
+
+// Valid reports whether p is a known PluginAvailability value.
+func (p PluginAvailability) Valid() bool {
+	_, ok := validPluginAvailabilities[p]
+	return ok
 }
 
 func validateOptionalPluginAvailabilityField(field string, value *PluginAvailability) error {
@@ -68,10 +108,23 @@ const (
 	PluginListMarketplaceKindSharedWithMe       PluginListMarketplaceKind = "shared-with-me"
 )
 
-var validPluginListMarketplaceKinds = map[PluginListMarketplaceKind]struct{}{
-	PluginListMarketplaceKindLocal:              {},
-	PluginListMarketplaceKindWorkspaceDirectory: {},
-	PluginListMarketplaceKindSharedWithMe:       {},
+var allPluginListMarketplaceKinds = []PluginListMarketplaceKind{
+	PluginListMarketplaceKindLocal,
+	PluginListMarketplaceKindWorkspaceDirectory,
+	PluginListMarketplaceKindSharedWithMe,
+}
+
+var validPluginListMarketplaceKinds = newEnumSet(allPluginListMarketplaceKinds...)
+
+// PluginListMarketplaceKindValues returns every known PluginListMarketplaceKind value in protocol order.
+func PluginListMarketplaceKindValues() []PluginListMarketplaceKind {
+	return slices.Clone(allPluginListMarketplaceKinds)
+}
+
+// Valid reports whether p is a known PluginListMarketplaceKind value.
+func (p PluginListMarketplaceKind) Valid() bool {
+	_, ok := validPluginListMarketplaceKinds[p]
+	return ok
 }
 
 const (
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"slices"
 )
 
 // PluginHookSummary describes a hook bundled with a plugin.
@@ -21,10 +22,23 @@ const (
 	PluginShareDiscoverabilityPrivate  PluginShareDiscoverability = "PRIVATE"
 )
 
-var validPluginShareDiscoverabilities = map[PluginShareDiscoverability]struct{}{
-	PluginShareDiscoverabilityListed:   {},
-	PluginShareDiscoverabilityUnlisted: {},
-	PluginShareDiscoverabilityPrivate:  {},
+var allPluginShareDiscoverabilities = []PluginShareDiscoverability{
+	PluginShareDiscoverabilityListed,
+	PluginShareDiscoverabilityUnlisted,
+	PluginShareDiscoverabilityPrivate,
+}
+
+var validPluginShareDiscoverabilities = newEnumSet(allPluginShareDiscoverabilities...)
+
+// PluginShareDiscoverabilityValues returns every known PluginShareDiscoverability value in protocol order.
+func PluginShareDiscoverabilityValues() []PluginShareDiscoverability {
+	return slices.Clone(allPluginShareDiscoverabilities)
+}
+
+// Valid reports whether d is a known PluginShareDiscoverability value.
+func (d PluginShareDiscoverability) Valid() bool {
+	_, ok := validPluginShareDiscoverabilities[d]
+	return ok
 }
 
 func (d PluginShareDiscoverability) MarshalJSON() ([]byte, error) {
@@ -43,9 +57,22 @@ const (
 	PluginShareUpdateDiscoverabilityPrivate  PluginShareUpdateDiscoverability = "PRIVATE"
 )
 
-var validPluginShareUpdateDiscoverabilities = map[PluginShareUpdateDiscoverability]struct{}{
-	PluginShareUpdateDiscoverabilityUnlisted: {},
-	PluginShareUpdateDiscoverabilityPrivate:  {},
+var allPluginShareUpdateDiscoverabilities = []PluginShareUpdateDiscoverability{
+	PluginShareUpdateDiscoverabilityUnlisted,
+	PluginShareUpdateDiscoverabilityPrivate,
+}
+
+var validPluginShareUpdateDiscoverabilities = newEnumSet(allPluginShareUpdateDiscoverabilities...)
+
+// PluginShareUpdateDiscoverabilityValues returns every known PluginShareUpdateDiscoverability value in protocol order.
+func PluginShareUpdateDiscoverabilityValues() []PluginShareUpdateDiscoverability {
+	return slices.Clone(allPluginShareUpdateDiscoverabilities)
+}
+
+// Valid reports whether d is a known PluginShareUpdateDiscoverability value.
+func (d PluginShareUpdateDiscoverability) Valid() bool {
+	_, ok := validPluginShareUpdateDiscoverabilities[d]
+	return ok
 }
 
 func (d PluginShareUpdateDiscoverability) MarshalJSON() ([]byte, error) {
@@ -65,10 +92,23 @@ const (
 	PluginSharePrincipalTypeWorkspace PluginSharePrincipalType = "workspace"
 )
 
-var validPluginSharePrincipalTypes = map[PluginSharePrincipalType]struct{}{
-	PluginSharePrincipalTypeUser:      {},
-	PluginSharePrincipalTypeGroup:     {},
-	PluginSharePrincipalTypeWorkspace: {},
+var allPluginSharePrincipalTypes = []PluginSharePrincipalType{
+	PluginSharePrincipalTypeUser,
+	PluginSharePrincipalTypeGroup,
+	PluginSharePrincipalTypeWorkspace,
+}
+
+var validPluginSharePrincipalTypes = newEnumSet(allPluginSharePrincipalTypes...)
+
+// PluginSharePrincipalTypeValues returns every known PluginSharePrincipalType value in protocol order.
+func PluginSharePrincipalTypeValues() []PluginSharePrincipalType {
+	return slices.Clone(allPluginSharePrincipalTypes)
+}
+
+// Valid reports whether t is a known PluginSharePrincipalType value.
+func (t PluginSharePrincipalType) Valid() bool {
+	_, ok := validPluginSharePrincipalTypes[t]
+	return ok
 }
 
 func (t PluginSharePrincipalType) MarshalJSON() ([]byte, error) {
@@ -88,10 +128,23 @@ const (
 	PluginSharePrincipalRoleOwner  PluginSharePrincipalRole = "owner"
 )
 
-var validPluginSharePrincipalRoles = map[PluginSharePrincipalRole]struct{}{
-	PluginSharePrincipalRoleReader: {},
-	PluginSharePrincipalRoleEditor: {},
-	PluginSharePrincipalRoleOwner:  {},
+var allPluginSharePrincipalRoles = []PluginSharePrincipalRole{
+	PluginSharePrincipalRoleReader,
+	PluginSharePrincipalRoleEditor,
+	PluginSharePrincipalRoleOwner,
+}
+
+var validPluginSharePrincipalRoles = newEnumSet(allPluginSharePrincipalRoles...)
+
+// PluginSharePrincipalRoleValues returns every known PluginSharePrincipalRole value in protocol order.
+func PluginSharePrincipalRoleValues() []PluginSharePrincipalRole {
+	return slices.Clone(allPluginSharePrincipalRoles)
+}
+
+// Valid reports whether r is a known PluginSharePrincipalRole value.
+func (r PluginSharePrincipalRole) Valid() bool {
+	_, ok := validPluginSharePrincipalRoles[r]
+	return ok
 }
 
 func (r *PluginSharePrincipalRole) UnmarshalJSON(data []byte) error {
@@ -106,9 +159,22 @@ const (
 	PluginShareTargetRoleEditor PluginShareTargetRole = "editor"
 )
 
-var validPluginShareTargetRoles = map[PluginShareTargetRole]struct{}{
-	PluginShareTargetRoleReader: {},
-	PluginShareTargetRoleEditor: {},
+var allPluginShareTargetRoles = []PluginShareTargetRole{
+	PluginShareTargetRoleReader,
+	PluginShareTargetRoleEditor,
+}
+
+var validPluginShareTargetRoles = newEnumSet(allPluginShareTargetRoles...)
+
+// PluginShareTargetRoleValues returns every known PluginShareTargetRole value in protocol order.
+func PluginShareTargetRoleValues() []PluginShareTargetRole {
+	return slices.Clone(allPluginShareTargetRoles)
+}
+
+// Valid reports whether r is a known PluginShareTargetRole value.
+func (r PluginShareTargetRole) Valid() bool {
+	_, ok := validPluginShareTargetRoles[r]
+	return ok
 }
 
 func (r PluginShareTargetRole) MarshalJSON() ([]byte, error) {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 )
 
 // ProcessOutputStream labels process output streams.
@@ -14,9 +15,22 @@ const (
 	ProcessOutputStreamStderr ProcessOutputStream = "stderr"
 )
 
-var validProcessOutputStreams = map[ProcessOutputStream]struct{}{
-	ProcessOutputStreamStdout: {},
-	ProcessOutputStreamStderr: {},
+var allProcessOutputStreams = []ProcessOutputStream{
+	ProcessOutputStreamStdout,
+	ProcessOutputStreamStderr,
+}
+
+var validProcessOutputStreams = newEnumSet(allProcessOutputStreams...)
+
+// ProcessOutputStreamValues returns every known ProcessOutputStream value in protocol order.
+func ProcessOutputStreamValues() []ProcessOutputStream {
+	return slices.Clone(allProcessOutputStreams)
+}
+
+// Valid reports whether p is a known ProcessOutputStream value.
+func (p ProcessOutputStream) Valid() bool {
+	_, ok := validProcessOutputStreams[p]
+	return ok
 }
 
 func validateProcessOutputStream(stream ProcessOutputStream) error {
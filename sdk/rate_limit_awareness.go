@@ -0,0 +1,125 @@
+package codex
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// WithRateLimitAwareness makes the client hold back turn/start requests while
+// the account is rate limited. The client watches account/rateLimits/updated
+// notifications; when a reported window is exhausted and carries a reset
+// time, turn/start waits in Send until that reset instead of being sent and
+// rejected. No hold is placed while the snapshot reports credits (hasCredits
+// or unlimited) that cover the overage. Each rate limit (by limitId) is
+// tracked separately and a later update for the same limit lifts its hold.
+// Other methods are never delayed.
+//
+// The wait honors the caller's context: if it is canceled or its deadline
+// passes first, Send returns a CanceledError or TimeoutError without sending.
+// The default request timeout from WithRequestTimeout applies only once the
+// request is actually sent. Without this option the client never delays
+// requests.
+func WithRateLimitAwareness() ClientOption {
+	return func(c *Client) {
+		c.rateLimitAware = true
+	}
+}
+
+func (c *Client) installRateLimitTracker() {
+	if !c.rateLimitAware {
+		return
+	}
+	c.addNotificationListener(notifyAccountRateLimitsUpdated, func(_ context.Context, notif Notification) {
+		var n AccountRateLimitsUpdatedNotification
+		if err := json.Unmarshal(notif.Params, &n); err != nil {
+			c.reportHandlerError(notifyAccountRateLimitsUpdated, fmt.Errorf("unmarshal %s: %w", notifyAccountRateLimitsUpdated, err))
+			return
+		}
+		c.recordRateLimits(n.RateLimits, time.Now())
+	})
+}
+
+func (c *Client) recordRateLimits(snapshot RateLimitSnapshot, now time.Time) {
+	var limitID string
+	if snapshot.LimitId != nil {
+		limitID = *snapshot.LimitId
+	}
+
+	c.rateLimitMu.Lock()
+	defer c.rateLimitMu.Unlock()
+	defer c.notifyRateLimitChangeLocked()
+	wait := snapshot.retryAfter(now)
+	if wait <= 0 || creditsCoverOverage(snapshot.Credits) {
+		delete(c.rateLimitHolds, limitID)
+		return
+	}
+	if c.rateLimitHolds == nil {
+		c.rateLimitHolds = make(map[string]time.Time)
+	}
+	c.rateLimitHolds[limitID] = now.Add(wait)
+}
+
+// creditsCoverOverage reports whether the account can keep running turns past
+// an exhausted window by spending credits.
+func creditsCoverOverage(credits *CreditsSnapshot) bool {
+	return credits != nil && (credits.HasCredits || credits.Unlimited)
+}
+
+// notifyRateLimitChangeLocked wakes every request waiting on the current
+// holds. The caller must hold rateLimitMu.
+func (c *Client) notifyRateLimitChangeLocked() {
+	if c.rateLimitChanged != nil {
+		close(c.rateLimitChanged)
+		c.rateLimitChanged = nil
+	}
+}
+
+// rateLimitHoldUntil returns the latest reset time among the current holds,
+// or the zero time when nothing is rate limited, and a channel that is closed
+// when the holds next change.
+func (c *Client) rateLimitHoldUntil() (time.Time, <-chan struct{}) {
+	c.rateLimitMu.Lock()
+	defer c.rateLimitMu.Unlock()
+	var until time.Time
+	for _, resetAt := range c.rateLimitHolds {
+		if resetAt.After(until) {
+			until = resetAt
+		}
+	}
+	if c.rateLimitChanged == nil {
+		c.rateLimitChanged = make(chan struct{})
+	}
+	return until, c.rateLimitChanged
+}
+
+// waitForRateLimitReset blocks a turn/start request until every known rate
+// limit hold has expired or been lifted by a later update, or ctx is done.
+func (c *Client) waitForRateLimitReset(ctx context.Context, method string) error {
+	if !c.rateLimitAware || method != methodTurnStart {
+		return nil
+	}
+	for {
+		until, changed := c.rateLimitHoldUntil()
+		wait := time.Until(until)
+		if wait <= 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-changed:
+			timer.Stop()
+		case <-ctx.Done():
+			timer.Stop()
+			err := ctx.Err()
+			if errors.Is(err, context.DeadlineExceeded) {
+				return NewTimeoutError("deadline exceeded waiting for rate limit reset", err)
+			}
+			return NewCanceledError("cancelled while waiting for rate limit reset", err)
+		}
+	}
+}
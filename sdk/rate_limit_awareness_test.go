@@ -0,0 +1,132 @@
+package codex_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	codex "github.com/dominicnunez/codex-sdk-go/sdk"
+)
+
+func injectRateLimits(mock *MockTransport, limitID string, usedPercent int, resetsAt int64) {
+	mock.InjectServerNotification(context.Background(), codex.Notification{
+		JSONRPC: "2.0",
+		Method:  "account/rateLimits/updated",
+		Params: json.RawMessage(fmt.Sprintf(
+			`{"rateLimits":{"limitId":%q,"primary":{"usedPercent":%d,"resetsAt":%d}}}`,
+			limitID, usedPercent, resetsAt)),
+	})
+}
+
+func sendWithin(client *codex.Client, method string, d time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), d)
+	defer cancel()
+	_, err := client.Send(ctx, codex.Request{
+		JSONRPC: "2.0",
+		ID:      codex.RequestID{Value: uint64(1)},
+		Method:  method,
+		Params:  json.RawMessage(`{}`),
+	})
+	return err
+}
+
+func TestRateLimitAwarenessHoldsTurnStartUntilReset(t *testing.T) {
+	mock := NewMockTransport()
+	client := codex.NewClient(mock, codex.WithRateLimitAwareness())
+	_ = mock.SetResponseData("turn/start", map[string]interface{}{})
+	_ = mock.SetResponseData("thread/list", map[string]interface{}{})
+
+	resetsAt := time.Now().Add(time.Hour).Unix()
+	injectRateLimits(mock, "codex", 100, resetsAt)
+
+	err := sendWithin(client, "turn/start", 50*time.Millisecond)
+	var timeoutErr *codex.TimeoutError
+	if !errors.As(err, &timeoutErr) {
+		t.Fatalf("turn/start while rate limited: error = %v; want *codex.TimeoutError", err)
+	}
+	if got := mock.CallCount(); got != 0 {
+		t.Fatalf("transport calls = %d; want held turn/start not sent", got)
+	}
+
+	if err := sendWithin(client, "thread/list", time.Second); err != nil {
+		t.Fatalf("thread/list while rate limited: %v", err)
+	}
+
+	injectRateLimits(mock, "other", 10, resetsAt)
+	if err := sendWithin(client, "turn/start", 50*time.Millisecond); !errors.As(err, &timeoutErr) {
+		t.Fatalf("update for another limit lifted the hold: error = %v", err)
+	}
+
+	injectRateLimits(mock, "codex", 20, resetsAt)
+	if err := sendWithin(client, "turn/start", time.Second); err != nil {
+		t.Fatalf("turn/start after the limit recovered: %v", err)
+	}
+	if got := mock.CallCount(); got != 2 {
+		t.Fatalf("transport calls = %d; want thread/list and turn/start sent", got)
+	}
+}
+
+func TestRateLimitAwarenessOffDoesNotHoldTurnStart(t *testing.T) {
+	mock := NewMockTransport()
+	client := codex.NewClient(mock)
+	_ = mock.SetResponseData("turn/start", map[string]interface{}{})
+
+	injectRateLimits(mock, "codex", 100, time.Now().Add(time.Hour).Unix())
+	if err := sendWithin(client, "turn/start", time.Second); err != nil {
+		t.Fatalf("turn/start without rate limit awareness: %v", err)
+	}
+}
+
+func TestRateLimitAwarenessUpdateReleasesWaitingTurnStart(t *testing.T) {
+	mock := NewMockTransport()
+	client := codex.NewClient(mock, codex.WithRateLimitAwareness())
+	_ = mock.SetResponseData("turn/start", map[string]interface{}{})
+
+	resetsAt := time.Now().Add(time.Hour).Unix()
+	injectRateLimits(mock, "codex", 100, resetsAt)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- sendWithin(client, "turn/start", 10*time.Second)
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+	if got := mock.CallCount(); got != 0 {
+		t.Fatalf("transport calls = %d; want turn/start held", got)
+	}
+	injectRateLimits(mock, "codex", 20, resetsAt)
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("turn/start after the hold was lifted: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("turn/start kept waiting after a later update lifted the hold")
+	}
+	if got := mock.CallCount(); got != 1 {
+		t.Fatalf("transport calls = %d; want turn/start sent", got)
+	}
+}
+
+func TestRateLimitAwarenessSkipsHoldWhenCreditsCoverOverage(t *testing.T) {
+	for _, credits := range []string{`{"hasCredits":true,"unlimited":false}`, `{"hasCredits":false,"unlimited":true}`} {
+		mock := NewMockTransport()
+		client := codex.NewClient(mock, codex.WithRateLimitAwareness())
+		_ = mock.SetResponseData("turn/start", map[string]interface{}{})
+
+		mock.InjectServerNotification(context.Background(), codex.Notification{
+			JSONRPC: "2.0",
+			Method:  "account/rateLimits/updated",
+			Params: json.RawMessage(fmt.Sprintf(
+				`{"rateLimits":{"limitId":"codex","primary":{"usedPercent":100,"resetsAt":%d},"credits":%s}}`,
+				time.Now().Add(time.Hour).Unix(), credits)),
+		})
+		if err := sendWithin(client, "turn/start", time.Second); err != nil {
+			t.Fatalf("turn/start with credits %s: %v", credits, err)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 )
 
 // RealtimeConversationVersion identifies the realtime protocol version.
@@ -14,9 +15,22 @@ const (
 	RealtimeConversationVersionV2 RealtimeConversationVersion = "v2"
 )
 
-var validRealtimeConversationVersions = map[RealtimeConversationVersion]struct{}{
-	RealtimeConversationVersionV1: {},
-	RealtimeConversationVersionV2: {},
+var allRealtimeConversationVersions = []RealtimeConversationVersion{
+	RealtimeConversationVersionV1,
+	RealtimeConversationVersionV2,
+}
+
+var validRealtimeConversationVersions = newEnumSet(allRealtimeConversationVersions...)
+
+// RealtimeConversationVersionValues returns every known RealtimeConversationVersion value in protocol order.
+func RealtimeConversationVersionValues() []RealtimeConversationVersion {
+	return slices.Clone(allRealtimeConversationVersions)
+}
+
+// Valid reports whether v is a known RealtimeConversationVersion value.
+func (v RealtimeConversationVersion) Valid() bool {
+	_, ok := validRealtimeConversationVersions[v]
+	return ok
 }
 
 func (v *RealtimeConversationVersion) UnmarshalJSON(data []byte) error {
@@ -0,0 +1,71 @@
+package codex
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ReplayNotifications reads a JSONL log of JSON-RPC notifications from r and
+// dispatches each one through client's notification listeners, exactly as if
+// the client's transport had delivered it. Blank lines are ignored.
+//
+// Replay stops at the first malformed line and returns an error that names
+// its 1-based line number; notifications on earlier lines have already been
+// dispatched. Lines that carry an id are requests or responses, not
+// notifications, and are rejected rather than skipped.
+func ReplayNotifications(r io.Reader, client *Client) error {
+	if r == nil {
+		return errors.New("replay notifications: nil reader")
+	}
+	if client == nil {
+		return errors.New("replay notifications: nil client")
+	}
+
+	ctx := context.Background()
+	reader := bufio.NewReader(r)
+	for line := 1; ; line++ {
+		data, readErr := reader.ReadBytes('\n')
+		if readErr != nil && !errors.Is(readErr, io.EOF) {
+			return fmt.Errorf("replay notifications: line %d: %w", line, readErr)
+		}
+
+		if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 {
+			notif, err := decodeReplayNotification(trimmed)
+			if err != nil {
+				return fmt.Errorf("replay notifications: line %d: %w", line, err)
+			}
+			client.handleNotification(ctx, notif)
+		}
+
+		if errors.Is(readErr, io.EOF) {
+			return nil
+		}
+	}
+}
+
+func decodeReplayNotification(data []byte) (Notification, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return Notification{}, err
+	}
+	if _, ok := raw["id"]; ok {
+		return Notification{}, errors.New("message has an id; expected a notification")
+	}
+
+	var notif Notification
+	if err := json.Unmarshal(data, &notif); err != nil {
+		return Notification{}, err
+	}
+	if notif.Method == "" {
+		return Notification{}, errors.New("missing method")
+	}
+	if notif.JSONRPC == "" {
+		notif.JSONRPC = jsonrpcVersion
+	}
+	return notif, nil
+}
@@ -0,0 +1,67 @@
+package codex_test
+
+import (
+	"strings"
+	"testing"
+
+	codex "github.com/dominicnunez/codex-sdk-go/sdk"
+)
+
+func TestReplayNotificationsDispatchesToListeners(t *testing.T) {
+	client := codex.NewClient(NewMockTransport())
+
+	var deltas []string
+	client.OnAgentMessageDelta(func(n codex.AgentMessageDeltaNotification) {
+		deltas = append(deltas, n.Delta)
+	})
+
+	log := strings.Join([]string{
+		`{"jsonrpc":"2.0","method":"item/agentMessage/delta","params":{"delta":"Hel","itemId":"i","threadId":"t","turnId":"u"}}`,
+		``,
+		`{"method":"item/agentMessage/delta","params":{"delta":"lo","itemId":"i","threadId":"t","turnId":"u"}}`,
+	}, "\n")
+
+	if err := codex.ReplayNotifications(strings.NewReader(log), client); err != nil {
+		t.Fatalf("ReplayNotifications() error = %v", err)
+	}
+	if got := strings.Join(deltas, ""); got != "Hello" {
+		t.Fatalf("replayed deltas = %q; want %q", got, "Hello")
+	}
+}
+
+func TestReplayNotificationsReportsMalformedLineNumber(t *testing.T) {
+	tests := []struct {
+		name string
+		log  string
+		want string
+	}{
+		{
+			name: "invalid json",
+			log:  "{\"method\":\"thread/started\"}\n{not json",
+			want: "line 2",
+		},
+		{
+			name: "missing method",
+			log:  "\n\n{\"params\":{}}",
+			want: "line 3: missing method",
+		},
+		{
+			name: "request instead of notification",
+			log:  `{"id":1,"method":"item/tool/call","params":{}}`,
+			want: "line 1: message has an id",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := codex.NewClient(NewMockTransport())
+			err := codex.ReplayNotifications(strings.NewReader(tt.log), client)
+			if err == nil {
+				t.Fatal("expected replay error")
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("error = %v; want substring %q", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package codex
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// maxTrackedResolvedRequests bounds how many resolved server request IDs the
+// client remembers. The oldest resolution is forgotten first.
+const maxTrackedResolvedRequests = 256
+
+// IsRequestResolved reports whether a serverRequest/resolved notification has
+// arrived for the server→client request with the given ID, such as an
+// approval prompt the server no longer needs an answer for. Only the 256 most
+// recent resolutions are remembered, so false can also mean the resolution
+// was forgotten. Numeric IDs match regardless of their Go integer type.
+func (c *Client) IsRequestResolved(id RequestID) bool {
+	key, ok := resolvedRequestKey(id)
+	if !ok {
+		return false
+	}
+	c.resolvedRequestsMu.Lock()
+	defer c.resolvedRequestsMu.Unlock()
+	_, resolved := c.resolvedRequests[key]
+	return resolved
+}
+
+func (c *Client) recordResolvedRequest(id RequestID) {
+	key, ok := resolvedRequestKey(id)
+	if !ok {
+		return
+	}
+
+	c.resolvedRequestsMu.Lock()
+	defer c.resolvedRequestsMu.Unlock()
+	if _, seen := c.resolvedRequests[key]; seen {
+		return
+	}
+	if c.resolvedRequests == nil {
+		c.resolvedRequests = make(map[string]struct{})
+	}
+	c.resolvedRequests[key] = struct{}{}
+	c.resolvedRequestOrder = append(c.resolvedRequestOrder, key)
+
+	for len(c.resolvedRequestOrder) > maxTrackedResolvedRequests {
+		delete(c.resolvedRequests, c.resolvedRequestOrder[0])
+		c.resolvedRequestOrder = c.resolvedRequestOrder[1:]
+	}
+}
+
+// resolvedRequestKey maps an ID to a key that keeps numeric and string IDs
+// distinct, matching RequestID.Equal.
+func resolvedRequestKey(id RequestID) (string, bool) {
+	if n, ok := isNumericID(id.Value); ok {
+		return "n:" + n, true
+	}
+	if s, ok := id.Value.(string); ok {
+		return "s:" + s, true
+	}
+	return "", false
+}
+
+func (c *Client) installResolvedRequestTracker() {
+	c.addNotificationListener(notifyServerRequestResolved, func(_ context.Context, notif Notification) {
+		var n ServerRequestResolvedNotification
+		if err := json.Unmarshal(notif.Params, &n); err != nil {
+			c.reportHandlerError(notifyServerRequestResolved, fmt.Errorf("unmarshal %s: %w", notifyServerRequestResolved, err))
+			return
+		}
+		c.recordResolvedRequest(n.RequestID)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 )
 
 // ReviewDelivery specifies where to run the review.
@@ -16,9 +17,22 @@ const (
 	ReviewDeliveryDetached ReviewDelivery = "detached"
 )
 
-var validReviewDeliveries = map[ReviewDelivery]struct{}{
-	ReviewDeliveryInline:   {},
-	ReviewDeliveryDetached: {},
+var allReviewDeliveries = []ReviewDelivery{
+	ReviewDeliveryInline,
+	ReviewDeliveryDetached,
+}
+
+var validReviewDeliveries = newEnumSet(allReviewDeliveries...)
+
+// ReviewDeliveryValues returns every known ReviewDelivery value in protocol order.
+func ReviewDeliveryValues() []ReviewDelivery {
+	return slices.Clone(allReviewDeliveries)
+}
+
+// Valid reports whether d is a known ReviewDelivery value.
+func (d ReviewDelivery) Valid() bool {
+	_, ok := validReviewDeliveries[d]
+	return ok
 }
 
 func (d ReviewDelivery) MarshalJSON() ([]byte, error) {
@@ -0,0 +1,184 @@
+package codex
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrUnknownMethod indicates a JSON-RPC method has no typed payload registered
+// in this SDK.
+var ErrUnknownMethod = errors.New("unknown method")
+
+// notificationParamTypes maps every server notification method to a
+// constructor for its typed params.
+var notificationParamTypes = map[string]func() any{
+	notifyAgentMessageDelta:                   func() any { return new(AgentMessageDeltaNotification) },
+	notifyFileChangeOutputDelta:               func() any { return new(FileChangeOutputDeltaNotification) },
+	notifyPlanDelta:                           func() any { return new(PlanDeltaNotification) },
+	notifyReasoningTextDelta:                  func() any { return new(ReasoningTextDeltaNotification) },
+	notifyReasoningSummaryTextDelta:           func() any { return new(ReasoningSummaryTextDeltaNotification) },
+	notifyReasoningSummaryPartAdded:           func() any { return new(ReasoningSummaryPartAddedNotification) },
+	notifyItemStarted:                         func() any { return new(ItemStartedNotification) },
+	notifyItemCompleted:                       func() any { return new(ItemCompletedNotification) },
+	notifyThreadStarted:                       func() any { return new(ThreadStartedNotification) },
+	notifyThreadClosed:                        func() any { return new(ThreadClosedNotification) },
+	notifyThreadArchived:                      func() any { return new(ThreadArchivedNotification) },
+	notifyThreadUnarchived:                    func() any { return new(ThreadUnarchivedNotification) },
+	notifyThreadGoalUpdated:                   func() any { return new(ThreadGoalUpdatedNotification) },
+	notifyThreadGoalCleared:                   func() any { return new(ThreadGoalClearedNotification) },
+	notifyThreadNameUpdated:                   func() any { return new(ThreadNameUpdatedNotification) },
+	notifyThreadStatusChanged:                 func() any { return new(ThreadStatusChangedNotification) },
+	notifyThreadTokenUsageUpdated:             func() any { return new(ThreadTokenUsageUpdatedNotification) },
+	notifyTurnStarted:                         func() any { return new(TurnStartedNotification) },
+	notifyTurnCompleted:                       func() any { return new(TurnCompletedNotification) },
+	notifyTurnPlanUpdated:                     func() any { return new(TurnPlanUpdatedNotification) },
+	notifyTurnDiffUpdated:                     func() any { return new(TurnDiffUpdatedNotification) },
+	notifyAccountUpdated:                      func() any { return new(AccountUpdatedNotification) },
+	notifyAccountLoginCompleted:               func() any { return new(AccountLoginCompletedNotification) },
+	notifyAccountRateLimitsUpdated:            func() any { return new(AccountRateLimitsUpdatedNotification) },
+	notifyRealtimeStarted:                     func() any { return new(ThreadRealtimeStartedNotification) },
+	notifyRealtimeClosed:                      func() any { return new(ThreadRealtimeClosedNotification) },
+	notifyRealtimeError:                       func() any { return new(ThreadRealtimeErrorNotification) },
+	notifyRealtimeItemAdded:                   func() any { return new(ThreadRealtimeItemAddedNotification) },
+	notifyRealtimeOutputAudioDelta:            func() any { return new(ThreadRealtimeOutputAudioDeltaNotification) },
+	notifyRealtimeSdp:                         func() any { return new(ThreadRealtimeSdpNotification) },
+	notifyRealtimeTranscriptDelta:             func() any { return new(ThreadRealtimeTranscriptDeltaNotification) },
+	notifyRealtimeTranscriptDone:              func() any { return new(ThreadRealtimeTranscriptDoneNotification) },
+	notifyWindowsSandboxSetupCompleted:        func() any { return new(WindowsSandboxSetupCompletedNotification) },
+	notifyWindowsWorldWritableWarning:         func() any { return new(WindowsWorldWritableWarningNotification) },
+	notifyThreadCompacted:                     func() any { return new(ContextCompactedNotification) },
+	notifyDeprecationNotice:                   func() any { return new(DeprecationNoticeNotification) },
+	notifyError:                               func() any { return new(ErrorNotification) },
+	notifyWarning:                             func() any { return new(WarningNotification) },
+	notifyGuardianWarning:                     func() any { return new(GuardianWarningNotification) },
+	notifyRemoteControlStatusChanged:          func() any { return new(RemoteControlStatusChangedNotification) },
+	notifyTerminalInteraction:                 func() any { return new(TerminalInteractionNotification) },
+	notifyMcpServerOauthLoginCompleted:        func() any { return new(McpServerOauthLoginCompletedNotification) },
+	notifyMcpServerStatusUpdated:              func() any { return new(McpServerStatusUpdatedNotification) },
+	notifyMcpToolCallProgress:                 func() any { return new(McpToolCallProgressNotification) },
+	notifyServerRequestResolved:               func() any { return new(ServerRequestResolvedNotification) },
+	notifyModelRerouted:                       func() any { return new(ModelReroutedNotification) },
+	notifyModelVerification:                   func() any { return new(ModelVerificationNotification) },
+	notifyFuzzyFileSearchSessionCompleted:     func() any { return new(FuzzyFileSearchSessionCompletedNotification) },
+	notifyFuzzyFileSearchSessionUpdated:       func() any { return new(FuzzyFileSearchSessionUpdatedNotification) },
+	notifyCommandExecutionOutputDelta:         func() any { return new(CommandExecutionOutputDeltaNotification) },
+	notifyCommandExecOutputDelta:              func() any { return new(CommandExecOutputDeltaNotification) },
+	notifyFileChangePatchUpdated:              func() any { return new(FileChangePatchUpdatedNotification) },
+	notifyProcessOutputDelta:                  func() any { return new(ProcessOutputDeltaNotification) },
+	notifyProcessExited:                       func() any { return new(ProcessExitedNotification) },
+	notifyFsChanged:                           func() any { return new(FsChangedNotification) },
+	notifyExternalAgentConfigImportCompleted:  func() any { return new(ExternalAgentConfigImportCompletedNotification) },
+	notifyAppListUpdated:                      func() any { return new(AppListUpdatedNotification) },
+	notifyConfigWarning:                       func() any { return new(ConfigWarningNotification) },
+	notifySkillsChanged:                       func() any { return new(SkillsChangedNotification) },
+	notifyHookStarted:                         func() any { return new(HookStartedNotification) },
+	notifyHookCompleted:                       func() any { return new(HookCompletedNotification) },
+	notifyItemGuardianApprovalReviewStarted:   func() any { return new(ItemGuardianApprovalReviewStartedNotification) },
+	notifyItemGuardianApprovalReviewCompleted: func() any { return new(ItemGuardianApprovalReviewCompletedNotification) },
+}
+
+// serverRequestParamTypes maps every server→client request method to a
+// constructor for its typed params.
+var serverRequestParamTypes = map[string]func() any{
+	methodApplyPatchApproval:              func() any { return new(ApplyPatchApprovalParams) },
+	methodCommandExecutionRequestApproval: func() any { return new(CommandExecutionRequestApprovalParams) },
+	methodExecCommandApproval:             func() any { return new(ExecCommandApprovalParams) },
+	methodFileChangeRequestApproval:       func() any { return new(FileChangeRequestApprovalParams) },
+	methodPermissionsRequestApproval:      func() any { return new(PermissionsRequestApprovalParams) },
+	methodDynamicToolCall:                 func() any { return new(DynamicToolCallParams) },
+	methodToolRequestUserInput:            func() any { return new(ToolRequestUserInputParams) },
+	methodChatgptAuthTokensRefresh:        func() any { return new(ChatgptAuthTokensRefreshParams) },
+	methodMcpServerElicitationRequest:     func() any { return new(McpServerElicitationRequestParams) },
+	methodAttestationGenerate:             func() any { return new(AttestationGenerateParams) },
+}
+
+// serverRequestResultTypes maps every server→client request method to a
+// constructor for the typed result the client answers with.
+var serverRequestResultTypes = map[string]func() any{
+	methodApplyPatchApproval:              func() any { return new(ApplyPatchApprovalResponse) },
+	methodCommandExecutionRequestApproval: func() any { return new(CommandExecutionRequestApprovalResponse) },
+	methodExecCommandApproval:             func() any { return new(ExecCommandApprovalResponse) },
+	methodFileChangeRequestApproval:       func() any { return new(FileChangeRequestApprovalResponse) },
+	methodPermissionsRequestApproval:      func() any { return new(PermissionsRequestApprovalResponse) },
+	methodDynamicToolCall:                 func() any { return new(DynamicToolCallResponse) },
+	methodToolRequestUserInput:            func() any { return new(ToolRequestUserInputResponse) },
+	methodChatgptAuthTokensRefresh:        func() any { return new(ChatgptAuthTokensRefreshResponse) },
+	methodMcpServerElicitationRequest:     func() any { return new(McpServerElicitationRequestResponse) },
+	methodAttestationGenerate:             func() any { return new(AttestationGenerateResponse) },
+}
+
+// RoundTripNotification decodes a JSON-RPC notification into the typed params
+// registered for its method and re-encodes it. The returned message carries
+// exactly what the SDK would preserve from raw, which makes it suitable for
+// fuzz and property tests that check the custom (un)marshalers are lossless.
+// Unregistered methods return ErrUnknownMethod.
+func RoundTripNotification(raw json.RawMessage) (json.RawMessage, error) {
+	var notif Notification
+	if err := json.Unmarshal(raw, &notif); err != nil {
+		return nil, fmt.Errorf("decode notification: %w", err)
+	}
+	params, err := roundTripPayload(notificationParamTypes, notif.Method, notif.Params)
+	if err != nil {
+		return nil, err
+	}
+	notif.Params = params
+	return json.Marshal(notif)
+}
+
+// RoundTripServerRequest decodes a server→client JSON-RPC request into the
+// typed params registered for its method and re-encodes it. See
+// RoundTripNotification.
+func RoundTripServerRequest(raw json.RawMessage) (json.RawMessage, error) {
+	var req Request
+	if err := json.Unmarshal(raw, &req); err != nil {
+		return nil, fmt.Errorf("decode request: %w", err)
+	}
+	params, err := roundTripPayload(serverRequestParamTypes, req.Method, req.Params)
+	if err != nil {
+		return nil, err
+	}
+	req.Params = params
+	return json.Marshal(req)
+}
+
+// RoundTripServerResponse decodes the client's JSON-RPC response to a
+// server→client request for method and re-encodes it. Error responses carry no
+// typed result and are re-encoded as-is. See RoundTripNotification.
+func RoundTripServerResponse(method string, raw json.RawMessage) (json.RawMessage, error) {
+	var resp Response
+	if err := json.Unmarshal(raw, &resp); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+	if resp.Error == nil {
+		result, err := roundTripPayload(serverRequestResultTypes, method, resp.Result)
+		if err != nil {
+			return nil, err
+		}
+		resp.Result = result
+	}
+	return json.Marshal(resp)
+}
+
+func roundTripPayload(types map[string]func() any, method string, payload json.RawMessage) (json.RawMessage, error) {
+	newPayload, ok := types[method]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownMethod, method)
+	}
+	if isEmptyResponseResult(payload) {
+		return nil, fmt.Errorf("%s: %w", method, ErrEmptyResult)
+	}
+
+	value := newPayload()
+	if err := json.Unmarshal(payload, value); err != nil {
+		return nil, fmt.Errorf("decode %s payload: %w", method, err)
+	}
+	if err := validateDecodedResponse(value); err != nil {
+		return nil, fmt.Errorf("validate %s payload: %w", method, err)
+	}
+	data, err := marshalForWire(value)
+	if err != nil {
+		return nil, fmt.Errorf("encode %s payload: %w", method, err)
+	}
+	return data, nil
+}
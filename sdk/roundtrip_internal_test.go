@@ -0,0 +1,119 @@
+package codex
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestNotificationParamTypesCoverServerNotificationSpec(t *testing.T) {
+	methodByType := loadServerNotificationMethodsByType(t)
+	if len(methodByType) == 0 {
+		t.Fatal("found no server notification methods in spec")
+	}
+	for typeName, method := range methodByType {
+		newParams, ok := notificationParamTypes[method]
+		if !ok {
+			t.Errorf("%s (%s) has no registered param type", method, typeName)
+			continue
+		}
+		if got := reflect.TypeOf(newParams()).Elem().Name(); got != typeName {
+			t.Errorf("%s registered as %s; spec type is %s", method, got, typeName)
+		}
+	}
+}
+
+func TestRoundTripNotificationPreservesSpecSamples(t *testing.T) {
+	for typeName, method := range loadServerNotificationMethodsByType(t) {
+		t.Run(method, func(t *testing.T) {
+			raw := sampleNotificationMessage(t, method, typeName)
+			out, err := RoundTripNotification(raw)
+			if err != nil {
+				t.Fatalf("RoundTripNotification() error = %v", err)
+			}
+			assertJSONEquivalent(t, raw, out)
+		})
+	}
+}
+
+func TestRoundTripRejectsUnknownMethods(t *testing.T) {
+	_, err := RoundTripNotification(json.RawMessage(`{"jsonrpc":"2.0","method":"future/thing","params":{}}`))
+	if !errors.Is(err, ErrUnknownMethod) {
+		t.Fatalf("RoundTripNotification() error = %v; want ErrUnknownMethod", err)
+	}
+	_, err = RoundTripServerRequest(json.RawMessage(`{"jsonrpc":"2.0","id":1,"method":"future/thing","params":{}}`))
+	if !errors.Is(err, ErrUnknownMethod) {
+		t.Fatalf("RoundTripServerRequest() error = %v; want ErrUnknownMethod", err)
+	}
+}
+
+func TestRoundTripServerRequestAndResponse(t *testing.T) {
+	req := json.RawMessage(`{"jsonrpc":"2.0","id":7,"method":"item/commandExecution/requestApproval","params":{"itemId":"item-1","startedAtMs":1,"threadId":"thread-1","turnId":"turn-1","networkApprovalContext":{"host":"example.com","protocol":"https"}}}`)
+	out, err := RoundTripServerRequest(req)
+	if err != nil {
+		t.Fatalf("RoundTripServerRequest() error = %v", err)
+	}
+	assertJSONEquivalent(t, req, out)
+
+	resp := json.RawMessage(`{"jsonrpc":"2.0","id":7,"result":{"decision":{"applyNetworkPolicyAmendment":{"network_policy_amendment":{"action":"allow","host":"example.com"}}}}}`)
+	out, err = RoundTripServerResponse(methodCommandExecutionRequestApproval, resp)
+	if err != nil {
+		t.Fatalf("RoundTripServerResponse() error = %v", err)
+	}
+	assertJSONEquivalent(t, resp, out)
+
+	if _, err := RoundTripServerResponse(methodCommandExecutionRequestApproval, json.RawMessage(`{"jsonrpc":"2.0","id":7,"result":{"decision":"maybe"}}`)); err == nil {
+		t.Fatal("expected invalid decision error")
+	}
+}
+
+func FuzzRoundTripNotification(f *testing.F) {
+	for typeName, method := range loadServerNotificationMethodsByType(f) {
+		f.Add([]byte(sampleNotificationMessage(f, method, typeName)))
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		first, err := RoundTripNotification(data)
+		if err != nil {
+			return
+		}
+		second, err := RoundTripNotification(first)
+		if err != nil {
+			t.Fatalf("re-decoding round-tripped notification failed: %v\ninput: %s\nfirst: %s", err, data, first)
+		}
+		assertJSONEquivalent(t, first, second)
+	})
+}
+
+func sampleNotificationMessage(t testing.TB, method, typeName string) json.RawMessage {
+	t.Helper()
+	data, err := json.Marshal(Notification{
+		JSONRPC: jsonrpcVersion,
+		Method:  method,
+		Params:  sampleServerNotificationParams(t, typeName),
+	})
+	if err != nil {
+		t.Fatalf("marshal sample %s notification: %v", method, err)
+	}
+	return data
+}
+
+func assertJSONEquivalent(t testing.TB, want, got []byte) {
+	t.Helper()
+	var wantValue, gotValue interface{}
+	if err := json.Unmarshal(want, &wantValue); err != nil {
+		t.Fatalf("unmarshal want: %v", err)
+	}
+	if err := json.Unmarshal(got, &gotValue); err != nil {
+		t.Fatalf("unmarshal got: %v", err)
+	}
+	if !reflect.DeepEqual(wantValue, gotValue) {
+		wantPretty, _ := json.Marshal(wantValue)
+		gotPretty, _ := json.Marshal(gotValue)
+		if !bytes.Equal(wantPretty, gotPretty) {
+			t.Fatalf("round trip changed payload:\nwant %s\ngot  %s", wantPretty, gotPretty)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package codex
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
 )
 
 // SandboxPolicy represents sandbox access policy
@@ -40,6 +41,28 @@ type SandboxPolicyWorkspaceWrite struct {
 
 func (SandboxPolicyWorkspaceWrite) isSandboxPolicy() {}
 
+// RepoSandbox returns a workspace-write policy that lets the agent write only
+// under root, which should be an absolute path. Writes to /tmp and $TMPDIR are
+// excluded and network access is disabled. Use RepoSandboxWithNetwork when the
+// agent needs the network.
+func RepoSandbox(root string) SandboxPolicyWrapper {
+	return repoSandbox(root, false)
+}
+
+// RepoSandboxWithNetwork is RepoSandbox with network access enabled.
+func RepoSandboxWithNetwork(root string) SandboxPolicyWrapper {
+	return repoSandbox(root, true)
+}
+
+func repoSandbox(root string, networkAccess bool) SandboxPolicyWrapper {
+	return SandboxPolicyWrapper{Value: SandboxPolicyWorkspaceWrite{
+		ExcludeSlashTmp:     Ptr(true),
+		ExcludeTmpdirEnvVar: Ptr(true),
+		NetworkAccess:       Ptr(networkAccess),
+		WritableRoots:       []string{root},
+	}}
+}
+
 // UnknownSandboxPolicy represents an unrecognized sandbox policy type from a newer protocol version.
 type UnknownSandboxPolicy struct {
 	Type string          `json:"type"`
@@ -178,6 +201,24 @@ const (
 	NetworkAccessEnabled    NetworkAccess = "enabled"
 )
 
+var allNetworkAccesses = []NetworkAccess{
+	NetworkAccessRestricted,
+	NetworkAccessEnabled,
+}
+
+var validNetworkAccesses = newEnumSet(allNetworkAccesses...)
+
+// NetworkAccessValues returns every known NetworkAccess value in protocol order.
+func NetworkAccessValues() []NetworkAccess {
+	return slices.Clone(allNetworkAccesses)
+}
+
+// Valid reports whether n is a known NetworkAccess value.
+func (n NetworkAccess) Valid() bool {
+	_, ok := validNetworkAccesses[n]
+	return ok
+}
+
 // SandboxPolicyWrapper wraps SandboxPolicy for JSON marshaling
 type SandboxPolicyWrapper struct {
 	Value SandboxPolicy
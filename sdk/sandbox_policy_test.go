@@ -0,0 +1,39 @@
+package codex_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	codex "github.com/dominicnunez/codex-sdk-go/sdk"
+)
+
+func TestRepoSandboxMarshalsWorkspaceWritePolicy(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy codex.SandboxPolicyWrapper
+		want   string
+	}{
+		{
+			name:   "without network",
+			policy: codex.RepoSandbox("/work/repo"),
+			want:   `{"type":"workspaceWrite","excludeSlashTmp":true,"excludeTmpdirEnvVar":true,"networkAccess":false,"writableRoots":["/work/repo"]}`,
+		},
+		{
+			name:   "with network",
+			policy: codex.RepoSandboxWithNetwork("/work/repo"),
+			want:   `{"type":"workspaceWrite","excludeSlashTmp":true,"excludeTmpdirEnvVar":true,"networkAccess":true,"writableRoots":["/work/repo"]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.policy)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Marshal() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
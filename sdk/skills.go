@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 )
 
 // SkillScope defines the scope of a skill (user, repo, system, admin)
@@ -16,11 +17,24 @@ const (
 	SkillScopeAdmin  SkillScope = "admin"
 )
 
-var validSkillScopes = map[SkillScope]struct{}{
-	SkillScopeUser:   {},
-	SkillScopeRepo:   {},
-	SkillScopeSystem: {},
-	SkillScopeAdmin:  {},
+var allSkillScopes = []SkillScope{
+	SkillScopeUser,
+	SkillScopeRepo,
+	SkillScopeSystem,
+	SkillScopeAdmin,
+}
+
+var validSkillScopes = newEnumSet(allSkillScopes...)
+
+// SkillScopeValues returns every known SkillScope value in protocol order.
+func SkillScopeValues() []SkillScope {
+	return slices.Clone(allSkillScopes)
+}
+
+// Valid reports whether s is a known SkillScope value.
+func (s SkillScope) Valid() bool {
+	_, ok := validSkillScopes[s]
+	return ok
 }
 
 func (s *SkillScope) UnmarshalJSON(data []byte) error {
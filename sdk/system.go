@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 )
 
 // WindowsSandboxSetupMode represents the sandbox setup mode
@@ -14,9 +15,22 @@ const (
 	WindowsSandboxSetupModeUnelevated WindowsSandboxSetupMode = "unelevated"
 )
 
-var validWindowsSandboxSetupModes = map[WindowsSandboxSetupMode]struct{}{
-	WindowsSandboxSetupModeElevated:   {},
-	WindowsSandboxSetupModeUnelevated: {},
+var allWindowsSandboxSetupModes = []WindowsSandboxSetupMode{
+	WindowsSandboxSetupModeElevated,
+	WindowsSandboxSetupModeUnelevated,
+}
+
+var validWindowsSandboxSetupModes = newEnumSet(allWindowsSandboxSetupModes...)
+
+// WindowsSandboxSetupModeValues returns every known WindowsSandboxSetupMode value in protocol order.
+func WindowsSandboxSetupModeValues() []WindowsSandboxSetupMode {
+	return slices.Clone(allWindowsSandboxSetupModes)
+}
+
+// Valid reports whether m is a known WindowsSandboxSetupMode value.
+func (m WindowsSandboxSetupMode) Valid() bool {
+	_, ok := validWindowsSandboxSetupModes[m]
+	return ok
 }
 
 func (m *WindowsSandboxSetupMode) UnmarshalJSON(data []byte) error {
@@ -49,10 +63,23 @@ const (
 	WindowsSandboxReadinessUpdateRequired WindowsSandboxReadiness = "updateRequired"
 )
 
-var validWindowsSandboxReadinesses = map[WindowsSandboxReadiness]struct{}{
-	WindowsSandboxReadinessReady:          {},
-	WindowsSandboxReadinessNotConfigured:  {},
-	WindowsSandboxReadinessUpdateRequired: {},
+var allWindowsSandboxReadinesses = []WindowsSandboxReadiness{
+	WindowsSandboxReadinessReady,
+	WindowsSandboxReadinessNotConfigured,
+	WindowsSandboxReadinessUpdateRequired,
+}
+
+var validWindowsSandboxReadinesses = newEnumSet(allWindowsSandboxReadinesses...)
+
+// WindowsSandboxReadinessValues returns every known WindowsSandboxReadiness value in protocol order.
+func WindowsSandboxReadinessValues() []WindowsSandboxReadiness {
+	return slices.Clone(allWindowsSandboxReadinesses)
+}
+
+// Valid reports whether r is a known WindowsSandboxReadiness value.
+func (r WindowsSandboxReadiness) Valid() bool {
+	_, ok := validWindowsSandboxReadinesses[r]
+	return ok
 }
 
 func (r *WindowsSandboxReadiness) UnmarshalJSON(data []byte) error {
@@ -237,6 +264,26 @@ const (
 	RemoteControlConnectionStatusErrored    RemoteControlConnectionStatus = "errored"
 )
 
+var allRemoteControlConnectionStatuses = []RemoteControlConnectionStatus{
+	RemoteControlConnectionStatusDisabled,
+	RemoteControlConnectionStatusConnecting,
+	RemoteControlConnectionStatusConnected,
+	RemoteControlConnectionStatusErrored,
+}
+
+var validRemoteControlConnectionStatuses = newEnumSet(allRemoteControlConnectionStatuses...)
+
+// RemoteControlConnectionStatusValues returns every known RemoteControlConnectionStatus value in protocol order.
+func RemoteControlConnectionStatusValues() []RemoteControlConnectionStatus {
+	return slices.Clone(allRemoteControlConnectionStatuses)
+}
+
+// Valid reports whether r is a known RemoteControlConnectionStatus value.
+func (r RemoteControlConnectionStatus) Valid() bool {
+	_, ok := validRemoteControlConnectionStatuses[r]
+	return ok
+}
+
 // RemoteControlStatusChangedNotification reports remote-control connection status.
 type RemoteControlStatusChangedNotification struct {
 	EnvironmentID  *string                       `json:"environmentId,omitempty"`
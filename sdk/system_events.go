@@ -0,0 +1,105 @@
+package codex
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+// systemEventBuffer is the channel capacity used by SystemEvents.
+const systemEventBuffer = 64
+
+// SystemEvent is a notification that is not scoped to a thread. It is one of
+// AccountUpdatedNotification, AccountRateLimitsUpdatedNotification, or
+// ConfigWarningNotification.
+type SystemEvent interface {
+	systemEvent()
+}
+
+func (AccountUpdatedNotification) systemEvent()           {}
+func (AccountRateLimitsUpdatedNotification) systemEvent() {}
+func (ConfigWarningNotification) systemEvent()            {}
+
+// SystemEvents returns a channel that receives account/updated,
+// account/rateLimits/updated, and configWarning notifications as typed
+// SystemEvent values, in arrival order. It works alongside any OnAccountUpdated,
+// OnAccountRateLimitsUpdated, or OnConfigWarning listeners. It returns
+// ErrNilContext if ctx is nil.
+//
+// The channel is buffered and never blocks notification dispatch: an event
+// arriving while the buffer is full is dropped and counted in
+// DroppedSystemEvents, so callers should drain it promptly. The channel is
+// closed after ctx is done, and events arriving after that are dropped
+// without being counted. Notifications whose params fail to decode are
+// reported through the handler error callback instead of being sent.
+func (c *Client) SystemEvents(ctx context.Context) (<-chan SystemEvent, error) {
+	if err := validateContext(ctx); err != nil {
+		return nil, err
+	}
+	stream := &systemEventStream{events: make(chan SystemEvent, systemEventBuffer)}
+
+	unsubscribe := []func(){
+		c.addNotificationListener(notifyAccountUpdated, systemEventListener[AccountUpdatedNotification](c, stream, notifyAccountUpdated)),
+		c.addNotificationListener(notifyAccountRateLimitsUpdated, systemEventListener[AccountRateLimitsUpdatedNotification](c, stream, notifyAccountRateLimitsUpdated)),
+		c.addNotificationListener(notifyConfigWarning, systemEventListener[ConfigWarningNotification](c, stream, notifyConfigWarning)),
+	}
+
+	go func() {
+		<-ctx.Done()
+		for _, unsub := range unsubscribe {
+			unsub()
+		}
+		stream.close()
+	}()
+
+	return stream.events, nil
+}
+
+// DroppedSystemEvents returns how many events SystemEvents channels have
+// dropped because their buffer was full.
+func (c *Client) DroppedSystemEvents() uint64 {
+	return c.droppedSystemEvents.Load()
+}
+
+type systemEventStream struct {
+	mu     sync.Mutex
+	closed bool
+	events chan SystemEvent
+}
+
+// send delivers event without blocking and reports whether it was dropped
+// because the buffer was full.
+func (s *systemEventStream) send(event SystemEvent) (dropped bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return false
+	}
+	select {
+	case s.events <- event:
+		return false
+	default:
+		return true
+	}
+}
+
+func (s *systemEventStream) close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed = true
+	close(s.events)
+}
+
+func systemEventListener[T SystemEvent](c *Client, stream *systemEventStream, method string) NotificationHandler {
+	return func(_ context.Context, notif Notification) {
+		var event T
+		if err := json.Unmarshal(notif.Params, &event); err != nil {
+			c.reportHandlerError(method, fmt.Errorf("unmarshal %s: %w", method, err))
+			return
+		}
+		if stream.send(event) {
+			c.droppedSystemEvents.Add(1)
+		}
+	}
+}
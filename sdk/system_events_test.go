@@ -0,0 +1,115 @@
+package codex_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	codex "github.com/dominicnunez/codex-sdk-go/sdk"
+)
+
+func TestClientSystemEventsAggregatesAccountAndConfigNotifications(t *testing.T) {
+	mock := NewMockTransport()
+	client := codex.NewClient(mock)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events, err := client.SystemEvents(ctx)
+	if err != nil {
+		t.Fatalf("SystemEvents() error = %v", err)
+	}
+
+	var listenerCalled bool
+	client.OnConfigWarning(func(codex.ConfigWarningNotification) {
+		listenerCalled = true
+	})
+
+	notifications := []codex.Notification{
+		{JSONRPC: "2.0", Method: "account/updated", Params: json.RawMessage(`{"authMode":"apikey"}`)},
+		{JSONRPC: "2.0", Method: "item/agentMessage/delta", Params: json.RawMessage(`{"delta":"x","itemId":"i","threadId":"t","turnId":"u"}`)},
+		{JSONRPC: "2.0", Method: "account/rateLimits/updated", Params: json.RawMessage(`{"rateLimits":{}}`)},
+		{JSONRPC: "2.0", Method: "configWarning", Params: json.RawMessage(`{"summary":"bad key"}`)},
+	}
+	for _, notif := range notifications {
+		mock.InjectServerNotification(context.Background(), notif)
+	}
+
+	first := receiveSystemEvent(t, events)
+	if updated, ok := first.(codex.AccountUpdatedNotification); !ok || updated.AuthMode == nil || *updated.AuthMode != codex.AuthModeAPIKey {
+		t.Fatalf("first event = %#v; want AccountUpdatedNotification with apikey auth", first)
+	}
+	if _, ok := receiveSystemEvent(t, events).(codex.AccountRateLimitsUpdatedNotification); !ok {
+		t.Fatal("second event is not AccountRateLimitsUpdatedNotification")
+	}
+	third := receiveSystemEvent(t, events)
+	if warning, ok := third.(codex.ConfigWarningNotification); !ok || warning.Summary != "bad key" {
+		t.Fatalf("third event = %#v; want ConfigWarningNotification", third)
+	}
+	if !listenerCalled {
+		t.Fatal("OnConfigWarning listener was not called alongside SystemEvents")
+	}
+
+	cancel()
+	select {
+	case event, ok := <-events:
+		if ok {
+			t.Fatalf("unexpected event after cancel: %#v", event)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("SystemEvents channel was not closed after cancel")
+	}
+
+	mock.InjectServerNotification(context.Background(), notifications[0])
+}
+
+func TestClientSystemEventsDropsWhenBufferFull(t *testing.T) {
+	mock := NewMockTransport()
+	client := codex.NewClient(mock)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events, err := client.SystemEvents(ctx)
+	if err != nil {
+		t.Fatalf("SystemEvents() error = %v", err)
+	}
+
+	const sent = 70
+	for i := 0; i < sent; i++ {
+		mock.InjectServerNotification(context.Background(), codex.Notification{
+			JSONRPC: "2.0",
+			Method:  "configWarning",
+			Params:  json.RawMessage(`{"summary":"warning"}`),
+		})
+	}
+
+	if got := len(events); got != cap(events) {
+		t.Fatalf("buffered events = %d; want a full buffer of %d", got, cap(events))
+	}
+	if got := client.DroppedSystemEvents(); got != uint64(sent-cap(events)) {
+		t.Errorf("DroppedSystemEvents() = %d; want %d", got, sent-cap(events))
+	}
+}
+
+func TestClientSystemEventsRejectsNilContext(t *testing.T) {
+	client := codex.NewClient(NewMockTransport())
+	//nolint:staticcheck // nil context is intentional: this test verifies the guard path.
+	events, err := client.SystemEvents(nil)
+	if !errors.Is(err, codex.ErrNilContext) || events != nil {
+		t.Fatalf("SystemEvents(nil) = %v, %v; want nil, ErrNilContext", events, err)
+	}
+}
+
+func receiveSystemEvent(t *testing.T, events <-chan codex.SystemEvent) codex.SystemEvent {
+	t.Helper()
+	select {
+	case event, ok := <-events:
+		if !ok {
+			t.Fatal("SystemEvents channel closed early")
+		}
+		return event
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for system event")
+		return nil
+	}
+}
@@ -13,6 +13,7 @@ type Thread struct {
 	Cwd           string               `json:"cwd"`
 	ModelProvider string               `json:"modelProvider"`
 	Preview       string               `json:"preview"`
+	SessionID     string               `json:"sessionId,omitempty"` // Shared by threads in the same session tree; empty from servers that predate it
 	Source        SessionSourceWrapper `json:"source"`
 	Status        ThreadStatusWrapper  `json:"status"`
 	ThreadSource  *ThreadSource        `json:"threadSource,omitempty"`
@@ -34,6 +35,7 @@ func (t *Thread) UnmarshalJSON(data []byte) error {
 		Cwd           *string               `json:"cwd"`
 		ModelProvider *string               `json:"modelProvider"`
 		Preview       *string               `json:"preview"`
+		SessionID     *string               `json:"sessionId"`
 		Source        *SessionSourceWrapper `json:"source"`
 		Status        *ThreadStatusWrapper  `json:"status"`
 		ThreadSource  *ThreadSource         `json:"threadSource"`
@@ -87,6 +89,9 @@ func (t *Thread) UnmarshalJSON(data []byte) error {
 	t.Cwd = validatedCwd
 	t.ModelProvider = *wire.ModelProvider
 	t.Preview = *wire.Preview
+	if wire.SessionID != nil {
+		t.SessionID = *wire.SessionID
+	}
 	t.Source = *wire.Source
 	t.Status = *wire.Status
 	t.ThreadSource = wire.ThreadSource
@@ -135,7 +140,8 @@ func (t *Turn) UnmarshalJSON(data []byte) error {
 
 // TurnError represents an error in a turn.
 // It implements the error interface so callers can use errors.As to inspect
-// structured fields (CodexErrorInfo, AdditionalDetails).
+// structured fields (CodexErrorInfo, AdditionalDetails). Use Info to decode
+// CodexErrorInfo into a typed value.
 type TurnError struct {
 	Message           string          `json:"message"`
 	CodexErrorInfo    json.RawMessage `json:"codexErrorInfo,omitempty"`
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 )
 
 // ThreadStartedNotification is sent when a thread is started
@@ -80,6 +81,26 @@ const (
 	ThreadGoalStatusComplete      ThreadGoalStatus = "complete"
 )
 
+var allThreadGoalStatuses = []ThreadGoalStatus{
+	ThreadGoalStatusActive,
+	ThreadGoalStatusPaused,
+	ThreadGoalStatusBudgetLimited,
+	ThreadGoalStatusComplete,
+}
+
+var validThreadGoalStatuses = newEnumSet(allThreadGoalStatuses...)
+
+// ThreadGoalStatusValues returns every known ThreadGoalStatus value in protocol order.
+func ThreadGoalStatusValues() []ThreadGoalStatus {
+	return slices.Clone(allThreadGoalStatuses)
+}
+
+// Valid reports whether t is a known ThreadGoalStatus value.
+func (t ThreadGoalStatus) Valid() bool {
+	_, ok := validThreadGoalStatuses[t]
+	return ok
+}
+
 // ThreadGoal describes goal-tracking state for a thread.
 type ThreadGoal struct {
 	CreatedAt       int64            `json:"createdAt"`
@@ -3,6 +3,8 @@ package codex_test
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"strconv"
 	"strings"
 	"testing"
 
@@ -337,6 +339,50 @@ func TestServerRequestResolvedListener(t *testing.T) {
 	}
 }
 
+// TestIsRequestResolved tests that serverRequest/resolved notifications are
+// tracked by request ID and that the tracked set is bounded.
+func TestIsRequestResolved(t *testing.T) {
+	mock := NewMockTransport()
+	client := codex.NewClient(mock)
+	ctx := context.Background()
+
+	resolve := func(id string) {
+		mock.InjectServerNotification(ctx, codex.Notification{
+			JSONRPC: "2.0",
+			Method:  "serverRequest/resolved",
+			Params:  json.RawMessage(`{"requestId": ` + id + `, "threadId": "thread-1"}`),
+		})
+	}
+
+	if client.IsRequestResolved(codex.RequestID{Value: int64(7)}) {
+		t.Fatal("request 7 resolved before any notification")
+	}
+
+	resolve(`7`)
+	resolve(`"approval-1"`)
+
+	for _, id := range []codex.RequestID{{Value: 7}, {Value: int64(7)}, {Value: float64(7)}, {Value: "approval-1"}} {
+		if !client.IsRequestResolved(id) {
+			t.Errorf("IsRequestResolved(%#v) = false, want true", id.Value)
+		}
+	}
+	for _, id := range []codex.RequestID{{Value: "7"}, {Value: 8}, {Value: "approval-2"}, {Value: nil}} {
+		if client.IsRequestResolved(id) {
+			t.Errorf("IsRequestResolved(%#v) = true, want false", id.Value)
+		}
+	}
+
+	for i := 1000; i < 1256; i++ {
+		resolve(strconv.Itoa(i))
+	}
+	if client.IsRequestResolved(codex.RequestID{Value: 7}) {
+		t.Error("oldest resolution should have been evicted")
+	}
+	if !client.IsRequestResolved(codex.RequestID{Value: 1255}) {
+		t.Error("newest resolution should be tracked")
+	}
+}
+
 // TestThreadNotificationListenerRegistration tests that notification listeners can be registered and dispatched
 func TestThreadNotificationListenerRegistration(t *testing.T) {
 	mock := NewMockTransport()
@@ -544,3 +590,54 @@ func TestThreadNotificationListenerRegistration(t *testing.T) {
 		}
 	})
 }
+
+func TestClientThreadUsageTracksLatestPerThread(t *testing.T) {
+	ctx := context.Background()
+	mock := NewMockTransport()
+	client := codex.NewClient(mock)
+
+	if _, ok := client.ThreadUsage("thread-1"); ok {
+		t.Fatal("ThreadUsage() reported usage before any notification")
+	}
+
+	inject := func(threadID string, last, total int64) {
+		params := fmt.Sprintf(`{
+			"threadId": %q,
+			"turnId": "turn-1",
+			"tokenUsage": {
+				"last": {"cachedInputTokens": 0, "inputTokens": %d, "outputTokens": 0, "reasoningOutputTokens": 0, "totalTokens": %d},
+				"total": {"cachedInputTokens": 0, "inputTokens": %d, "outputTokens": 0, "reasoningOutputTokens": 0, "totalTokens": %d},
+				"modelContextWindow": 200000
+			}
+		}`, threadID, last, last, total, total)
+		mock.InjectServerNotification(ctx, codex.Notification{
+			JSONRPC: "2.0",
+			Method:  "thread/tokenUsage/updated",
+			Params:  json.RawMessage(params),
+		})
+	}
+
+	inject("thread-1", 100, 100)
+	inject("thread-2", 7, 7)
+	inject("thread-1", 40, 140)
+
+	usage, ok := client.ThreadUsage("thread-1")
+	if !ok {
+		t.Fatal("ThreadUsage(thread-1) missing")
+	}
+	if usage.Last.TotalTokens != 40 || usage.Total.TotalTokens != 140 {
+		t.Fatalf("thread-1 usage last/total = %d/%d; want 40/140", usage.Last.TotalTokens, usage.Total.TotalTokens)
+	}
+	if usage.ModelContextWindow == nil || *usage.ModelContextWindow != 200000 {
+		t.Fatalf("thread-1 model context window = %v; want 200000", usage.ModelContextWindow)
+	}
+	*usage.ModelContextWindow = 1
+	if again, _ := client.ThreadUsage("thread-1"); *again.ModelContextWindow != 200000 {
+		t.Fatal("ThreadUsage() exposed internal state to caller mutation")
+	}
+
+	usage, ok = client.ThreadUsage("thread-2")
+	if !ok || usage.Total.TotalTokens != 7 {
+		t.Fatalf("ThreadUsage(thread-2) = %+v, %v; want total 7", usage, ok)
+	}
+}
@@ -1,10 +1,14 @@
 package codex
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"iter"
+	"math"
+	"slices"
 )
 
 // ThreadService provides methods for thread lifecycle management
@@ -44,9 +48,22 @@ const (
 	ThreadStartSourceClear   ThreadStartSource = "clear"
 )
 
-var validThreadStartSources = map[ThreadStartSource]struct{}{
-	ThreadStartSourceStartup: {},
-	ThreadStartSourceClear:   {},
+var allThreadStartSources = []ThreadStartSource{
+	ThreadStartSourceStartup,
+	ThreadStartSourceClear,
+}
+
+var validThreadStartSources = newEnumSet(allThreadStartSources...)
+
+// ThreadStartSourceValues returns every known ThreadStartSource value in protocol order.
+func ThreadStartSourceValues() []ThreadStartSource {
+	return slices.Clone(allThreadStartSources)
+}
+
+// Valid reports whether s is a known ThreadStartSource value.
+func (s ThreadStartSource) Valid() bool {
+	_, ok := validThreadStartSources[s]
+	return ok
 }
 
 func (s ThreadStartSource) MarshalJSON() ([]byte, error) {
@@ -152,6 +169,55 @@ func (r ThreadStartResponse) validate() error {
 	)
 }
 
+// ThreadSettings is the effective configuration the server reports for a
+// thread when it is started, resumed, or forked.
+type ThreadSettings struct {
+	ApprovalPolicy    AskForApprovalWrapper
+	ApprovalsReviewer ApprovalsReviewer
+	Cwd               string
+	Model             string
+	ModelProvider     string
+	ReasoningEffort   *ReasoningEffort
+	Sandbox           SandboxPolicyWrapper
+	ServiceTier       *ServiceTier
+}
+
+func newThreadSettings(
+	approvalPolicy AskForApprovalWrapper,
+	approvalsReviewer ApprovalsReviewer,
+	cwd string,
+	model string,
+	modelProvider string,
+	reasoningEffort *ReasoningEffort,
+	sandbox SandboxPolicyWrapper,
+	serviceTier *ServiceTier,
+) ThreadSettings {
+	return cloneArbitraryValue(ThreadSettings{
+		ApprovalPolicy:    approvalPolicy,
+		ApprovalsReviewer: approvalsReviewer,
+		Cwd:               cwd,
+		Model:             model,
+		ModelProvider:     modelProvider,
+		ReasoningEffort:   reasoningEffort,
+		Sandbox:           sandbox,
+		ServiceTier:       serviceTier,
+	})
+}
+
+// Settings returns the effective thread configuration reported by thread/start.
+func (r ThreadStartResponse) Settings() ThreadSettings {
+	return newThreadSettings(
+		r.ApprovalPolicy,
+		r.ApprovalsReviewer,
+		r.Cwd,
+		r.Model,
+		r.ModelProvider,
+		r.ReasoningEffort,
+		r.Sandbox,
+		r.ServiceTier,
+	)
+}
+
 // Start initiates a new thread
 func (s *ThreadService) Start(ctx context.Context, params ThreadStartParams) (ThreadStartResponse, error) {
 	var response ThreadStartResponse
@@ -190,6 +256,127 @@ func (s *ThreadService) Read(ctx context.Context, params ThreadReadParams) (Thre
 	return response, nil
 }
 
+// ReadStreamed sends thread/read and yields the thread's turns one at a time.
+// The server still answers with a single response, but the turns are decoded
+// incrementally from it, so the fully parsed thread is never held in memory at
+// once. The request is sent when iteration starts. On failure the iterator
+// yields one zero Turn with the error and stops.
+//
+// Unlike Read, ReadStreamed does not validate the thread's other fields or
+// update the client's cached thread snapshot. Set params.IncludeTurns to true
+// to receive any turns.
+func (s *ThreadService) ReadStreamed(ctx context.Context, params ThreadReadParams) iter.Seq2[Turn, error] {
+	return func(yield func(Turn, error) bool) {
+		result, err := s.client.sendRequestRaw(ctx, methodThreadRead, params)
+		if err != nil {
+			yield(Turn{}, err)
+			return
+		}
+		if err := decodeThreadReadTurns(result, yield); err != nil {
+			yield(Turn{}, fmt.Errorf("%s: %w", methodThreadRead, err))
+		}
+	}
+}
+
+// decodeThreadReadTurns walks a thread/read result and passes each element of
+// thread.turns to yield as it is decoded. It returns nil early if yield asks
+// to stop.
+func decodeThreadReadTurns(result json.RawMessage, yield func(Turn, error) bool) error {
+	dec := json.NewDecoder(bytes.NewReader(result))
+	sawThread := false
+	err := decodeObjectFields(dec, "result", func(key string) (bool, error) {
+		if key != "thread" {
+			return true, skipJSONValue(dec)
+		}
+		sawThread = true
+		sawTurns := false
+		err := decodeObjectFields(dec, "thread", func(key string) (bool, error) {
+			if key != "turns" {
+				return true, skipJSONValue(dec)
+			}
+			sawTurns = true
+			if err := expectJSONDelim(dec, '[', "thread.turns"); err != nil {
+				return false, err
+			}
+			for dec.More() {
+				var turn Turn
+				if err := dec.Decode(&turn); err != nil {
+					return false, fmt.Errorf("decode thread.turns: %w", err)
+				}
+				if !yield(turn, nil) {
+					return false, nil
+				}
+			}
+			return true, expectJSONDelim(dec, ']', "thread.turns")
+		})
+		if err == nil && !sawTurns {
+			return false, errors.New("missing thread.turns")
+		}
+		return err == nil, err
+	})
+	if err == nil && !sawThread {
+		return errors.New("missing thread")
+	}
+	if errors.Is(err, errStopDecoding) {
+		return nil
+	}
+	return err
+}
+
+// errStopDecoding signals that decodeObjectFields stopped because its callback
+// asked it to, not because of malformed input.
+var errStopDecoding = errors.New("stop decoding")
+
+// decodeObjectFields reads a JSON object from dec, calling field for each key
+// with dec positioned at the key's value. field must consume the value and
+// reports whether decoding should continue.
+func decodeObjectFields(dec *json.Decoder, name string, field func(key string) (bool, error)) error {
+	if err := expectJSONDelim(dec, '{', name); err != nil {
+		return err
+	}
+	for dec.More() {
+		token, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		key, ok := token.(string)
+		if !ok {
+			return fmt.Errorf("%s: expected object key", name)
+		}
+		more, err := field(key)
+		if err != nil {
+			return err
+		}
+		if !more {
+			return errStopDecoding
+		}
+	}
+	return expectJSONDelim(dec, '}', name)
+}
+
+func expectJSONDelim(dec *json.Decoder, want json.Delim, name string) error {
+	token, err := dec.Token()
+	if err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != want {
+		if want == '{' || want == '[' {
+			kind := "object"
+			if want == '[' {
+				kind = "array"
+			}
+			return fmt.Errorf("%s: expected %s", name, kind)
+		}
+		return fmt.Errorf("%s: malformed JSON", name)
+	}
+	return nil
+}
+
+func skipJSONValue(dec *json.Decoder) error {
+	var discard json.RawMessage
+	return dec.Decode(&discard)
+}
+
 // ThreadListParams are parameters for listing threads
 type ThreadListParams struct {
 	Archived       *bool              `json:"archived,omitempty"`
@@ -236,6 +423,31 @@ func (s *ThreadService) List(ctx context.Context, params ThreadListParams) (Thre
 	return response, nil
 }
 
+// ListRecent lists threads ordered by most recently updated first. A limit of
+// zero or less leaves the page size to the server default.
+func (s *ThreadService) ListRecent(ctx context.Context, limit int) (ThreadListResponse, error) {
+	params := ThreadListParams{
+		SortKey:       Ptr(ThreadSortKeyUpdatedAt),
+		SortDirection: Ptr(SortDirectionDesc),
+	}
+	if limit > 0 {
+		if uint64(limit) > math.MaxUint32 {
+			return ThreadListResponse{}, fmt.Errorf("%s: limit %d exceeds uint32", methodThreadList, limit)
+		}
+		params.Limit = Ptr(uint32(limit))
+	}
+	return s.List(ctx, params)
+}
+
+// ListBySource lists threads whose source matches any of kinds. At least one
+// kind is required; use List with empty params to list every source.
+func (s *ThreadService) ListBySource(ctx context.Context, kinds ...ThreadSourceKind) (ThreadListResponse, error) {
+	if len(kinds) == 0 {
+		return ThreadListResponse{}, fmt.Errorf("%s: at least one source kind is required", methodThreadList)
+	}
+	return s.List(ctx, ThreadListParams{SourceKinds: slices.Clone(kinds)})
+}
+
 // ThreadLoadedListParams are parameters for listing loaded threads
 type ThreadLoadedListParams struct {
 	Cursor *string `json:"cursor,omitempty"`
@@ -278,9 +490,22 @@ const (
 	SortDirectionDesc SortDirection = "desc"
 )
 
-var validSortDirections = map[SortDirection]struct{}{
-	SortDirectionAsc:  {},
-	SortDirectionDesc: {},
+var allSortDirections = []SortDirection{
+	SortDirectionAsc,
+	SortDirectionDesc,
+}
+
+var validSortDirections = newEnumSet(allSortDirections...)
+
+// SortDirectionValues returns every known SortDirection value in protocol order.
+func SortDirectionValues() []SortDirection {
+	return slices.Clone(allSortDirections)
+}
+
+// Valid reports whether d is a known SortDirection value.
+func (d SortDirection) Valid() bool {
+	_, ok := validSortDirections[d]
+	return ok
 }
 
 func (d SortDirection) MarshalJSON() ([]byte, error) {
@@ -404,6 +629,20 @@ func (r ThreadResumeResponse) validate() error {
 	)
 }
 
+// Settings returns the effective thread configuration reported by thread/resume.
+func (r ThreadResumeResponse) Settings() ThreadSettings {
+	return newThreadSettings(
+		r.ApprovalPolicy,
+		r.ApprovalsReviewer,
+		r.Cwd,
+		r.Model,
+		r.ModelProvider,
+		r.ReasoningEffort,
+		r.Sandbox,
+		r.ServiceTier,
+	)
+}
+
 // Resume resumes an existing thread
 func (s *ThreadService) Resume(ctx context.Context, params ThreadResumeParams) (ThreadResumeResponse, error) {
 	var response ThreadResumeResponse
@@ -477,6 +716,20 @@ func (r ThreadForkResponse) validate() error {
 	)
 }
 
+// Settings returns the effective thread configuration reported by thread/fork.
+func (r ThreadForkResponse) Settings() ThreadSettings {
+	return newThreadSettings(
+		r.ApprovalPolicy,
+		r.ApprovalsReviewer,
+		r.Cwd,
+		r.Model,
+		r.ModelProvider,
+		r.ReasoningEffort,
+		r.Sandbox,
+		r.ServiceTier,
+	)
+}
+
 // Fork creates a fork of a thread
 func (s *ThreadService) Fork(ctx context.Context, params ThreadForkParams) (ThreadForkResponse, error) {
 	var response ThreadForkResponse
@@ -1135,6 +1135,66 @@ func TestThreadList(t *testing.T) {
 	})
 }
 
+func TestThreadListConvenienceHelpers(t *testing.T) {
+	t.Run("list recent sorts by updatedAt descending", func(t *testing.T) {
+		transport := NewMockTransport()
+		client := codex.NewClient(transport)
+		_ = transport.SetResponseData("thread/list", map[string]interface{}{"data": []interface{}{}})
+
+		if _, err := client.Thread.ListRecent(context.Background(), 20); err != nil {
+			t.Fatalf("Thread.ListRecent failed: %v", err)
+		}
+
+		var params map[string]interface{}
+		if err := json.Unmarshal(transport.GetSentRequest(0).Params, &params); err != nil {
+			t.Fatalf("unmarshal params: %v", err)
+		}
+		if params["sortKey"] != "updated_at" || params["sortDirection"] != "desc" || params["limit"] != float64(20) {
+			t.Fatalf("params = %v; want updated_at/desc/20", params)
+		}
+	})
+
+	t.Run("list recent omits non-positive limit", func(t *testing.T) {
+		transport := NewMockTransport()
+		client := codex.NewClient(transport)
+		_ = transport.SetResponseData("thread/list", map[string]interface{}{"data": []interface{}{}})
+
+		if _, err := client.Thread.ListRecent(context.Background(), 0); err != nil {
+			t.Fatalf("Thread.ListRecent failed: %v", err)
+		}
+		if strings.Contains(string(transport.GetSentRequest(0).Params), "limit") {
+			t.Fatalf("params = %s; want no limit", transport.GetSentRequest(0).Params)
+		}
+	})
+
+	t.Run("list by source sends source kinds", func(t *testing.T) {
+		transport := NewMockTransport()
+		client := codex.NewClient(transport)
+		_ = transport.SetResponseData("thread/list", map[string]interface{}{"data": []interface{}{}})
+
+		_, err := client.Thread.ListBySource(context.Background(), codex.ThreadSourceKindCLI, codex.ThreadSourceKindExec)
+		if err != nil {
+			t.Fatalf("Thread.ListBySource failed: %v", err)
+		}
+		got := string(transport.GetSentRequest(0).Params)
+		if got != `{"sourceKinds":["cli","exec"]}` {
+			t.Fatalf("params = %s", got)
+		}
+	})
+
+	t.Run("list by source requires a kind", func(t *testing.T) {
+		transport := NewMockTransport()
+		client := codex.NewClient(transport)
+
+		if _, err := client.Thread.ListBySource(context.Background()); err == nil {
+			t.Fatal("expected error for empty source kinds")
+		}
+		if transport.CallCount() != 0 {
+			t.Fatalf("sent %d requests; want 0", transport.CallCount())
+		}
+	})
+}
+
 // TestThreadLoadedList tests the ThreadService.LoadedList method
 func TestThreadLoadedList(t *testing.T) {
 	transport := NewMockTransport()
@@ -1926,6 +1986,81 @@ func TestThreadReadMalformedResult(t *testing.T) {
 	}
 }
 
+func TestThreadLifecycleResponseSettings(t *testing.T) {
+	fixture := validThreadLifecycleResponse(map[string]interface{}{
+		"id":            "thread-settings",
+		"cliVersion":    "1.0.0",
+		"createdAt":     int64(1),
+		"cwd":           "/test/dir",
+		"ephemeral":     false,
+		"modelProvider": "openai",
+		"preview":       "",
+		"source":        "cli",
+		"status":        map[string]interface{}{"type": "idle"},
+		"turns":         []interface{}{},
+		"updatedAt":     int64(1),
+	})
+	fixture["reasoningEffort"] = "high"
+	fixture["serviceTier"] = "flex"
+
+	data, err := json.Marshal(fixture)
+	if err != nil {
+		t.Fatalf("marshal fixture: %v", err)
+	}
+
+	check := func(t *testing.T, settings codex.ThreadSettings) {
+		t.Helper()
+		if settings.Model != "gpt-4" || settings.ModelProvider != "openai" || settings.Cwd != "/test/dir" {
+			t.Fatalf("settings = %+v; want gpt-4/openai//test/dir", settings)
+		}
+		if settings.ReasoningEffort == nil || *settings.ReasoningEffort != codex.ReasoningEffortHigh {
+			t.Fatalf("ReasoningEffort = %v; want high", settings.ReasoningEffort)
+		}
+		if settings.ServiceTier == nil || *settings.ServiceTier != codex.ServiceTierFlex {
+			t.Fatalf("ServiceTier = %v; want flex", settings.ServiceTier)
+		}
+		if settings.ApprovalsReviewer != codex.ApprovalsReviewerUser {
+			t.Fatalf("ApprovalsReviewer = %q; want user", settings.ApprovalsReviewer)
+		}
+		if settings.ApprovalPolicy.Value != codex.ApprovalPolicyUntrusted {
+			t.Fatalf("ApprovalPolicy = %#v; want untrusted", settings.ApprovalPolicy.Value)
+		}
+		if _, ok := settings.Sandbox.Value.(codex.SandboxPolicyDangerFullAccess); !ok {
+			t.Fatalf("Sandbox = %T; want SandboxPolicyDangerFullAccess", settings.Sandbox.Value)
+		}
+	}
+
+	t.Run("start", func(t *testing.T) {
+		var resp codex.ThreadStartResponse
+		if err := json.Unmarshal(data, &resp); err != nil {
+			t.Fatalf("unmarshal: %v", err)
+		}
+		settings := resp.Settings()
+		check(t, settings)
+
+		*settings.ReasoningEffort = codex.ReasoningEffortLow
+		if *resp.ReasoningEffort != codex.ReasoningEffortHigh {
+			t.Fatal("Settings() shares ReasoningEffort with the response")
+		}
+	})
+
+	t.Run("resume", func(t *testing.T) {
+		var resp codex.ThreadResumeResponse
+		if err := json.Unmarshal(data, &resp); err != nil {
+			t.Fatalf("unmarshal: %v", err)
+		}
+		check(t, resp.Settings())
+	})
+
+	t.Run("fork", func(t *testing.T) {
+		var resp codex.ThreadForkResponse
+		if err := json.Unmarshal(data, &resp); err != nil {
+			t.Fatalf("unmarshal: %v", err)
+		}
+		check(t, resp.Settings())
+	})
+}
+
 func validThreadLifecycleResponse(thread map[string]interface{}) map[string]interface{} {
 	return map[string]interface{}{
 		"approvalPolicy":    "untrusted",
@@ -1942,3 +2077,77 @@ func validThreadLifecycleResponse(thread map[string]interface{}) map[string]inte
 func boolPtr(b bool) *bool {
 	return &b
 }
+
+func TestThreadReadStreamedYieldsTurnsIncrementally(t *testing.T) {
+	mock := NewMockTransport()
+	client := codex.NewClient(mock)
+
+	thread := validThreadPayload("thread-1")
+	thread["turns"] = []interface{}{
+		map[string]interface{}{"id": "turn-1", "status": "completed", "items": []interface{}{}},
+		map[string]interface{}{"id": "turn-2", "status": "interrupted", "items": []interface{}{}},
+		map[string]interface{}{"id": "turn-3", "status": "completed", "items": []interface{}{}},
+	}
+	if err := mock.SetResponseData("thread/read", map[string]interface{}{"thread": thread}); err != nil {
+		t.Fatalf("SetResponseData: %v", err)
+	}
+
+	var ids []string
+	for turn, err := range client.Thread.ReadStreamed(context.Background(), codex.ThreadReadParams{ThreadID: "thread-1", IncludeTurns: boolPtr(true)}) {
+		if err != nil {
+			t.Fatalf("ReadStreamed() error = %v", err)
+		}
+		ids = append(ids, turn.ID)
+		if len(ids) == 2 {
+			break
+		}
+	}
+	if strings.Join(ids, ",") != "turn-1,turn-2" {
+		t.Fatalf("streamed turn IDs = %v; want first two turns before break", ids)
+	}
+	if mock.CallCount() != 1 {
+		t.Fatalf("CallCount() = %d; want one thread/read request", mock.CallCount())
+	}
+	if _, ok := client.ThreadStateSnapshot("thread-1"); ok {
+		t.Fatal("ReadStreamed() should not cache a thread snapshot")
+	}
+}
+
+func TestThreadReadStreamedReportsMalformedResults(t *testing.T) {
+	tests := []struct {
+		name    string
+		result  interface{}
+		wantErr string
+	}{
+		{name: "missing thread", result: map[string]interface{}{"other": 1}, wantErr: "missing thread"},
+		{name: "missing turns", result: map[string]interface{}{"thread": map[string]interface{}{"id": "t"}}, wantErr: "missing thread.turns"},
+		{name: "turns not array", result: map[string]interface{}{"thread": map[string]interface{}{"turns": "x"}}, wantErr: "thread.turns: expected array"},
+		{
+			name: "invalid turn",
+			result: map[string]interface{}{"thread": map[string]interface{}{
+				"turns": []interface{}{map[string]interface{}{"id": "turn-1", "status": "bogus", "items": []interface{}{}}},
+			}},
+			wantErr: `invalid turn.status "bogus"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := NewMockTransport()
+			client := codex.NewClient(mock)
+			if err := mock.SetResponseData("thread/read", tt.result); err != nil {
+				t.Fatalf("SetResponseData: %v", err)
+			}
+
+			var gotErr error
+			for _, err := range client.Thread.ReadStreamed(context.Background(), codex.ThreadReadParams{ThreadID: "t"}) {
+				if err != nil {
+					gotErr = err
+				}
+			}
+			if gotErr == nil || !strings.Contains(gotErr.Error(), tt.wantErr) {
+				t.Fatalf("ReadStreamed() error = %v; want substring %q", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
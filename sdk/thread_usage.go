@@ -0,0 +1,62 @@
+package codex
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// maxTrackedThreadUsages bounds how many threads the client keeps token usage
+// for. The least recently updated thread is dropped first.
+const maxTrackedThreadUsages = 256
+
+// ThreadUsage returns the latest token usage reported for a thread by
+// thread/tokenUsage/updated notifications. Usage.Total is the server's
+// cumulative count for the whole thread; Usage.Last covers the most recent
+// turn. The bool is false if no usage has been reported for the thread, or if
+// it was evicted after usage for 256 more recently updated threads arrived.
+func (c *Client) ThreadUsage(threadID string) (ThreadTokenUsage, bool) {
+	c.threadUsageMu.Lock()
+	defer c.threadUsageMu.Unlock()
+	usage, ok := c.threadUsages[threadID]
+	if !ok {
+		return ThreadTokenUsage{}, false
+	}
+	return cloneArbitraryValue(usage), true
+}
+
+func (c *Client) recordThreadUsage(threadID string, usage ThreadTokenUsage) {
+	if threadID == "" {
+		return
+	}
+
+	c.threadUsageMu.Lock()
+	defer c.threadUsageMu.Unlock()
+	if c.threadUsages == nil {
+		c.threadUsages = make(map[string]ThreadTokenUsage)
+	}
+	for i, id := range c.threadUsageOrder {
+		if id == threadID {
+			c.threadUsageOrder = append(c.threadUsageOrder[:i], c.threadUsageOrder[i+1:]...)
+			break
+		}
+	}
+	c.threadUsageOrder = append(c.threadUsageOrder, threadID)
+	c.threadUsages[threadID] = cloneArbitraryValue(usage)
+
+	for len(c.threadUsageOrder) > maxTrackedThreadUsages {
+		delete(c.threadUsages, c.threadUsageOrder[0])
+		c.threadUsageOrder = c.threadUsageOrder[1:]
+	}
+}
+
+func (c *Client) installThreadUsageTracker() {
+	c.addNotificationListener(notifyThreadTokenUsageUpdated, func(_ context.Context, notif Notification) {
+		var n ThreadTokenUsageUpdatedNotification
+		if err := json.Unmarshal(notif.Params, &n); err != nil {
+			c.reportHandlerError(notifyThreadTokenUsageUpdated, fmt.Errorf("unmarshal %s: %w", notifyThreadTokenUsageUpdated, err))
+			return
+		}
+		c.recordThreadUsage(n.ThreadID, n.TokenUsage)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 )
 
 // ===== Turn Started Notification =====
@@ -106,10 +107,23 @@ const (
 	TurnPlanStepStatusCompleted  TurnPlanStepStatus = "completed"
 )
 
-var validTurnPlanStepStatuses = map[TurnPlanStepStatus]struct{}{
-	TurnPlanStepStatusPending:    {},
-	TurnPlanStepStatusInProgress: {},
-	TurnPlanStepStatusCompleted:  {},
+var allTurnPlanStepStatuses = []TurnPlanStepStatus{
+	TurnPlanStepStatusPending,
+	TurnPlanStepStatusInProgress,
+	TurnPlanStepStatusCompleted,
+}
+
+var validTurnPlanStepStatuses = newEnumSet(allTurnPlanStepStatuses...)
+
+// TurnPlanStepStatusValues returns every known TurnPlanStepStatus value in protocol order.
+func TurnPlanStepStatusValues() []TurnPlanStepStatus {
+	return slices.Clone(allTurnPlanStepStatuses)
+}
+
+// Valid reports whether s is a known TurnPlanStepStatus value.
+func (s TurnPlanStepStatus) Valid() bool {
+	_, ok := validTurnPlanStepStatuses[s]
+	return ok
 }
 
 func (s *TurnPlanStepStatus) UnmarshalJSON(data []byte) error {